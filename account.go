@@ -0,0 +1,44 @@
+package godo
+
+import "context"
+
+// AccountService handles communication with the account related methods of
+// the DigitalOcean API.
+type AccountService struct {
+	client *Client
+}
+
+// Account represents a DigitalOcean Account
+type Account struct {
+	DropletLimit  int    `json:"droplet_limit,omitempty"`
+	Email         string `json:"email,omitempty"`
+	UUID          string `json:"uuid,omitempty"`
+	EmailVerified bool   `json:"email_verified,omitempty"`
+	Status        string `json:"status,omitempty"`
+}
+
+type accountRoot struct {
+	Account *Account `json:"account"`
+}
+
+func (a Account) String() string {
+	return Stringify(a)
+}
+
+// Get DigitalOcean account info
+func (s *AccountService) Get(ctx context.Context) (*Account, *Response, error) {
+	path := "v2/account"
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(accountRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Account, resp, err
+}
@@ -0,0 +1,58 @@
+package godo
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAccount_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"account": {
+				"droplet_limit": 25,
+				"email": "sammy@digitalocean.com",
+				"uuid": "b6fr89dbf6d9156cace5f3c78dc9851d957381ef",
+				"email_verified": true,
+				"status": "active"
+			}
+		}`)
+	})
+
+	account, _, err := client.Account.Get(ctx)
+	if err != nil {
+		t.Errorf("Account.Get returned error: %v", err)
+	}
+
+	expected := &Account{
+		DropletLimit:  25,
+		Email:         "sammy@digitalocean.com",
+		UUID:          "b6fr89dbf6d9156cace5f3c78dc9851d957381ef",
+		EmailVerified: true,
+		Status:        "active",
+	}
+	if !reflect.DeepEqual(account, expected) {
+		t.Errorf("Account.Get returned %+v, expected %+v", account, expected)
+	}
+}
+
+func TestAccount_String(t *testing.T) {
+	account := &Account{
+		DropletLimit:  25,
+		Email:         "sammy@digitalocean.com",
+		UUID:          "b6fr89",
+		EmailVerified: true,
+		Status:        "active",
+	}
+
+	stringified := account.String()
+	expected := `godo.Account{DropletLimit:25, Email:"sammy@digitalocean.com", UUID:"b6fr89", EmailVerified:true, Status:"active"}`
+	if expected != stringified {
+		t.Errorf("Account.String returned %+v, expected %+v", stringified, expected)
+	}
+}
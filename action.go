@@ -1,6 +1,12 @@
 package godo
 
-import "fmt"
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
 
 const (
 	actionsBasePath = "v2/actions"
@@ -10,6 +16,9 @@ const (
 
 	//ActionCompleted is a completed action status
 	ActionCompleted = "completed"
+
+	// ActionErrored is a failed action status
+	ActionErrored = "errored"
 )
 
 // ImageActionsService handles communition with the image action related methods of the
@@ -38,10 +47,100 @@ type Action struct {
 }
 
 // List all actions
-func (s *ActionsService) List() ([]Action, *Response, error) {
-	path := actionsBasePath
+func (s *ActionsService) List(ctx context.Context) ([]Action, *Response, error) {
+	return s.list(ctx, actionsBasePath)
+}
+
+// ListByStatus lists the actions whose status matches status, starting from
+// the page of the action log selected by opt. The API doesn't filter by status,
+// so pages are filtered client side, and further pages are requested until a
+// page's worth of matching actions is found or the log ends. The page size is
+// opt.PerPage, or the size of the first page when that is zero.
+//
+// The returned Response is that of the last page requested; every matching
+// action up to the end of that page is returned, so there may be more than a
+// page's worth. Use the Response to resume from the following page.
+func (s *ActionsService) ListByStatus(ctx context.Context, status string, opt *ListOptions) ([]Action, *Response, error) {
+	path, err := addOptions(actionsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	perPage := 0
+	if opt != nil {
+		perPage = opt.PerPage
+	}
+
+	var actions []Action
+	resp, err := listPages(path, func(path string) (*Response, bool, error) {
+		page, resp, err := s.list(ctx, path)
+		if err != nil {
+			return resp, false, err
+		}
+
+		if perPage == 0 {
+			perPage = len(page)
+		}
+		for _, a := range page {
+			if a.Status == status {
+				actions = append(actions, a)
+			}
+		}
+
+		return resp, len(actions) >= perPage, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return actions, resp, nil
+}
+
+// ExportJSON writes the entire action log to w as a JSON array. The log is
+// requested a page at a time and each page is written before the next is
+// requested, so the whole log is never held in memory.
+//
+// Each page is written whole, but if an error occurs after the first page, w
+// is left holding an unterminated array of the pages written so far.
+func (s *ActionsService) ExportJSON(ctx context.Context, w io.Writer) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	first := true
+	_, err := listPages(actionsBasePath, func(path string) (*Response, bool, error) {
+		actions, resp, err := s.list(ctx, path)
+		if err != nil {
+			return resp, false, err
+		}
+
+		var buf bytes.Buffer
+		for _, a := range actions {
+			b, err := json.Marshal(a)
+			if err != nil {
+				return resp, false, err
+			}
+
+			if !first {
+				buf.WriteByte(',')
+			}
+			first = false
+			buf.Write(b)
+		}
+
+		_, err = buf.WriteTo(w)
+		return resp, false, err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]")
+	return err
+}
 
-	req, err := s.client.NewRequest("GET", path, nil)
+func (s *ActionsService) list(ctx context.Context, path string) ([]Action, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -55,9 +154,9 @@ func (s *ActionsService) List() ([]Action, *Response, error) {
 	return root.Actions, resp, err
 }
 
-func (s *ActionsService) Get(id int) (*Action, *Response, error) {
+func (s *ActionsService) Get(ctx context.Context, id int) (*Action, *Response, error) {
 	path := fmt.Sprintf("%s/%d", actionsBasePath, id)
-	req, err := s.client.NewRequest("GET", path, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1,12 +1,77 @@
 package godo
 
-// ActionRequest reprents DigitalOcean Action Request
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ActionRequest reprents DigitalOcean Action Request. Params are sent beside
+// Type, at the top level of the request body, as the API expects:
+// {"type":"resize","size":"1gb"}.
 type ActionRequest struct {
 	Type   string                 `json:"type"`
-	Params map[string]interface{} `json:"params,omitempty"`
+	Params map[string]interface{} `json:"-"`
 }
 
 // Converts an ActionRequest to a string.
 func (d ActionRequest) String() string {
 	return Stringify(d)
 }
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d ActionRequest) MarshalJSON() ([]byte, error) {
+	typ, err := marshalNoEscape(d.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]interface{}{}
+	for k, v := range d.Params {
+		if k != "type" {
+			params[k] = v
+		}
+	}
+	if len(params) == 0 {
+		return []byte(`{"type":` + string(typ) + `}`), nil
+	}
+
+	rest, err := marshalNoEscape(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep type first, followed by the params in key order
+	return append([]byte(`{"type":`+string(typ)+`,`), rest[1:]...), nil
+}
+
+// marshalNoEscape marshals v like json.Marshal, but leaves <, > and & as is,
+// as NewRequest does for the rest of the request body.
+func marshalNoEscape(v interface{}) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Every field other
+// than "type" is decoded into Params.
+func (d *ActionRequest) UnmarshalJSON(data []byte) error {
+	var body map[string]interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+
+	d.Type, _ = body["type"].(string)
+	delete(body, "type")
+
+	d.Params = nil
+	if len(body) > 0 {
+		d.Params = body
+	}
+
+	return nil
+}
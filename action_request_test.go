@@ -1,6 +1,12 @@
 package godo
 
-import "testing"
+import (
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
 
 func TestActionRequest_String(t *testing.T) {
 	action := &ActionRequest{
@@ -14,3 +20,48 @@ func TestActionRequest_String(t *testing.T) {
 		t.Errorf("Action.Stringify returned %+v, expected %+v", stringified, expected)
 	}
 }
+
+func TestActionRequest_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		request  ActionRequest
+		expected string
+	}{
+		{ActionRequest{Type: "power_on"}, `{"type":"power_on"}`},
+		{ActionRequest{Type: "rename", Params: map[string]interface{}{"name": "web"}}, `{"type":"rename","name":"web"}`},
+	}
+
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.request)
+		if err != nil {
+			t.Fatalf("json.Marshal returned error: %v", err)
+		}
+		if string(data) != tt.expected {
+			t.Errorf("json.Marshal(%v) = %s, expected %s", tt.request, data, tt.expected)
+		}
+
+		var decoded ActionRequest
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("json.Unmarshal returned error: %v", err)
+		}
+		if !reflect.DeepEqual(decoded, tt.request) {
+			t.Errorf("json.Unmarshal(%s) = %+v, expected %+v", data, decoded, tt.request)
+		}
+	}
+}
+
+func TestActionRequest_MarshalJSON_noEscapeHTML(t *testing.T) {
+	setup()
+	defer teardown()
+
+	request := &ActionRequest{Type: "rename", Params: map[string]interface{}{"name": "<web>&db"}}
+	req, err := client.NewRequest("POST", "v2/droplets/1/actions", request)
+	if err != nil {
+		t.Fatalf("NewRequest returned error: %v", err)
+	}
+
+	body, _ := ioutil.ReadAll(req.Body)
+	expected := `{"type":"rename","name":"<web>&db"}`
+	if got := strings.TrimSpace(string(body)); got != expected {
+		t.Errorf("Request body = %s, expected %s", got, expected)
+	}
+}
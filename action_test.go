@@ -1,6 +1,8 @@
 package godo
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -20,12 +22,125 @@ func TestAction_List(t *testing.T) {
 		testMethod(t, r, "GET")
 	})
 
-	actions, _, err := client.Actions.List()
+	actions, _, err := client.Actions.List(ctx)
 	assert.NoError(err)
 	expected := []Action{{ID: 1}, {ID: 2}}
 	assert.Equal(expected, actions)
 }
 
+func TestAction_ListByStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	assert := assert.New(t)
+
+	mux.HandleFunc("/v2/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("page") {
+		case "2":
+			testFormValues(t, r, values{"page": "2", "per_page": "3"})
+			w.Header().Set("Link", fmt.Sprintf(`<%s/v2/actions?page=3&per_page=3>; rel="next"`, server.URL))
+			fmt.Fprint(w, `{"actions": [{"id":1,"status":"completed"},{"id":2,"status":"errored"},{"id":3,"status":"completed"}]}`)
+		case "3":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/v2/actions?page=4&per_page=3>; rel="next"`, server.URL))
+			fmt.Fprint(w, `{"actions": [{"id":4,"status":"errored"},{"id":5,"status":"errored"},{"id":6,"status":"errored"}]}`)
+		default:
+			t.Errorf("Unexpected request for page %q", r.URL.Query().Get("page"))
+		}
+	})
+
+	actions, resp, err := client.Actions.ListByStatus(ctx, ActionErrored, &ListOptions{Page: 2, PerPage: 3})
+	assert.NoError(err)
+	expected := []Action{{ID: 2, Status: "errored"}, {ID: 4, Status: "errored"}, {ID: 5, Status: "errored"}, {ID: 6, Status: "errored"}}
+	assert.Equal(expected, actions)
+	assert.Equal(server.URL+"/v2/actions?page=4&per_page=3", resp.NextPage)
+}
+
+func TestAction_ListByStatus_lastPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	assert := assert.New(t)
+
+	mux.HandleFunc("/v2/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"actions": [{"id":3,"status":"completed"}]}`)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/v2/actions?page=2>; rel="next"`, server.URL))
+		fmt.Fprint(w, `{"actions": [{"id":1,"status":"completed"},{"id":2,"status":"errored"}]}`)
+	})
+
+	actions, resp, err := client.Actions.ListByStatus(ctx, ActionErrored, nil)
+	assert.NoError(err)
+	assert.Equal([]Action{{ID: 2, Status: "errored"}}, actions)
+	assert.Equal("", resp.NextPage)
+}
+
+func TestAction_ExportJSON(t *testing.T) {
+	setup()
+	defer teardown()
+
+	assert := assert.New(t)
+
+	mux.HandleFunc("/v2/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/v2/actions?page=2>; rel="next"`, server.URL))
+			fmt.Fprint(w, `{"actions": [{"id":1},{"id":2}]}`)
+		case "2":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/v2/actions?page=3>; rel="next"`, server.URL))
+			fmt.Fprint(w, `{"actions": [{"id":3}]}`)
+		case "3":
+			fmt.Fprint(w, `{"actions": [{"id":4},{"id":5}]}`)
+		}
+	})
+
+	var buf bytes.Buffer
+	err := client.Actions.ExportJSON(ctx, &buf)
+	assert.NoError(err)
+
+	var actions []Action
+	assert.NoError(json.Unmarshal(buf.Bytes(), &actions))
+	assert.Len(actions, 5)
+	assert.Equal(5, actions[4].ID)
+}
+
+func TestAction_ExportJSON_empty(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/actions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"actions": []}`)
+	})
+
+	var buf bytes.Buffer
+	err := client.Actions.ExportJSON(ctx, &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", buf.String())
+}
+
+func TestAction_ExportJSON_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/actions", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			http.Error(w, `{"message":"boom"}`, http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/v2/actions?page=2>; rel="next"`, server.URL))
+		fmt.Fprint(w, `{"actions": [{"id":1},{"id":2}]}`)
+	})
+
+	var buf bytes.Buffer
+	err := client.Actions.ExportJSON(ctx, &buf)
+	assert.Error(t, err)
+	assert.Equal(t, `[{"id":1,"status":"","type":"","started_at":null,"completed_at":null,"resource_id":0,"resource_type":""},{"id":2,"status":"","type":"","started_at":null,"completed_at":null,"resource_id":0,"resource_type":""}`, buf.String())
+}
+
 func TestAction_Get(t *testing.T) {
 	setup()
 	defer teardown()
@@ -37,7 +152,7 @@ func TestAction_Get(t *testing.T) {
 		testMethod(t, r, "GET")
 	})
 
-	action, _, err := client.Actions.Get(12345)
+	action, _, err := client.Actions.Get(ctx, 12345)
 	assert.NoError(err)
 	assert.Equal(12345, action.ID)
 }
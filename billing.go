@@ -0,0 +1,31 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+const invoicesBasePath = "v2/customers/my/invoices"
+
+// BillingService handles communication with the billing related methods of
+// the DigitalOcean API.
+type BillingService struct {
+	client *Client
+}
+
+// GetInvoicePDF writes the PDF of the invoice identified by uuid to w. The
+// response body is streamed to w as is, without being decoded.
+func (s *BillingService) GetInvoicePDF(ctx context.Context, uuid string, w io.Writer) (*Response, error) {
+	path := fmt.Sprintf("%s/%s/pdf", invoicesBasePath, uuid)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/pdf")
+
+	resp, err := s.client.Do(req, w)
+
+	return resp, err
+}
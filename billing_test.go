@@ -0,0 +1,34 @@
+package godo
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+)
+
+func TestBilling_GetInvoicePDF(t *testing.T) {
+	setup()
+	defer teardown()
+
+	pdf := []byte("%PDF-1.4\n\x00\x01\x02binary\n%%EOF")
+
+	mux.HandleFunc("/v2/customers/my/invoices/22737513-0ea7-4206-8ceb-98a575af7681/pdf", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if accept := r.Header.Get("Accept"); accept != "application/pdf" {
+			t.Errorf("Request Accept = %v, expected %v", accept, "application/pdf")
+		}
+
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write(pdf)
+	})
+
+	buf := new(bytes.Buffer)
+	_, err := client.Billing.GetInvoicePDF(ctx, "22737513-0ea7-4206-8ceb-98a575af7681", buf)
+	if err != nil {
+		t.Errorf("Billing.GetInvoicePDF returned error: %v", err)
+	}
+
+	if !bytes.Equal(buf.Bytes(), pdf) {
+		t.Errorf("Billing.GetInvoicePDF wrote %q, expected %q", buf.Bytes(), pdf)
+	}
+}
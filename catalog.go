@@ -0,0 +1,57 @@
+package godo
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Catalog holds the regions, sizes and distribution images that can be used
+// to create a Droplet.
+type Catalog struct {
+	Regions []Region
+	Sizes   []Size
+	Images  []Image
+}
+
+func (c Catalog) String() string {
+	return Stringify(c)
+}
+
+// Catalog fetches the regions, sizes and distribution images concurrently. If
+// any of the requests fail, their errors are aggregated into the returned
+// error alongside whatever could be fetched. If ctx is done before all of the
+// requests complete, the context's error is returned.
+func (c *Client) Catalog(ctx context.Context) (*Catalog, error) {
+	catalog := new(Catalog)
+	errs := make([]error, 3)
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		catalog.Regions, _, errs[0] = c.Regions.List(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		catalog.Sizes, _, errs[1] = c.Sizes.List(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		catalog.Images, _, errs[2] = c.Images.ListDistribution(ctx)
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-done:
+	}
+
+	return catalog, errors.Join(errs...)
+}
@@ -0,0 +1,71 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_Catalog(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/regions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"regions":[{"slug":"nyc1"},{"slug":"sfo1"}]}`)
+	})
+
+	mux.HandleFunc("/v2/sizes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"sizes":[{"slug":"512mb"}]}`)
+	})
+
+	mux.HandleFunc("/v2/images", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"type": "distribution"})
+		fmt.Fprint(w, `{"images":[{"id":1,"distribution":"Ubuntu"}]}`)
+	})
+
+	catalog, err := client.Catalog(context.Background())
+	if err != nil {
+		t.Errorf("Client.Catalog returned error: %v", err)
+	}
+
+	expected := &Catalog{
+		Regions: []Region{{Slug: "nyc1"}, {Slug: "sfo1"}},
+		Sizes:   []Size{{Slug: "512mb"}},
+		Images:  []Image{{ID: 1, Distribution: "Ubuntu"}},
+	}
+	if !reflect.DeepEqual(catalog, expected) {
+		t.Errorf("Client.Catalog returned %+v, expected %+v", catalog, expected)
+	}
+}
+
+func TestClient_Catalog_errors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/regions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"regions":[{"slug":"nyc1"}]}`)
+	})
+
+	mux.HandleFunc("/v2/sizes", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	})
+
+	mux.HandleFunc("/v2/images", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	})
+
+	catalog, err := client.Catalog(context.Background())
+	if err == nil {
+		t.Fatal("Client.Catalog expected an error")
+	}
+
+	expected := []Region{{Slug: "nyc1"}}
+	if !reflect.DeepEqual(catalog.Regions, expected) {
+		t.Errorf("Client.Catalog Regions = %+v, expected %+v", catalog.Regions, expected)
+	}
+}
@@ -2,14 +2,20 @@ package godo
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httputil"
 	"net/url"
+	"os"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -22,6 +28,20 @@ const (
 	userAgent      = "godo/" + libraryVersion
 	mediaType      = "application/json"
 
+	defaultActionPollInterval = 5 * time.Second
+
+	defaultMaxRetries = 3
+	minRetryWait      = 100 * time.Millisecond
+
+	defaultMaxIdleConnsPerHost = 16
+	defaultIdleConnTimeout     = 90 * time.Second
+
+	debugEnv = "GODO_DEBUG"
+
+	headerRequestID   = "X-Request-Id"
+	headerDeprecation = "Deprecation"
+	headerSunset      = "Sunset"
+
 	headerRateLimit     = "X-RateLimit-Limit"
 	headerRateRemaining = "X-RateLimit-Remaining"
 	headerRateReset     = "X-RateLimit-Reset"
@@ -32,26 +52,75 @@ type Client struct {
 	// HTTP client used to communicate with the DO API.
 	client *http.Client
 
-	// Base URL for API requests.
+	// Base URL for API requests. Request paths are resolved relative to it
+	// as if its path ended with a slash, so a BaseURL of
+	// https://proxy.example.com/api sends requests to /api/v2/...
 	BaseURL *url.URL
 
 	// User agent for client
 	UserAgent string
 
 	// Rate contains the current rate limit for the client as determined by the most recent
-	// API call.
+	// API call. Use RateLimit to read it while requests may be in flight.
 	Rate Rate
 
+	// rateMu guards Rate, which is updated by concurrent calls to Do
+	rateMu sync.Mutex
+
+	// ActionPollInterval is how often helpers that wait on an action or a
+	// Droplet poll the API when they are not given an interval.
+	ActionPollInterval time.Duration
+
+	// DebugLog, if set, receives a dump of every request sent and response
+	// received by Do. NewClient sets it to os.Stderr when the GODO_DEBUG
+	// environment variable is set to a true value, such as "1" or "true".
+	DebugLog io.Writer
+
+	// OnDeprecation, if set, is called by Do when a response marks the
+	// requested endpoint as deprecated with a Deprecation or Sunset header.
+	// sunset is when the endpoint will stop working, or the zero Time if the
+	// API didn't say.
+	OnDeprecation func(path string, sunset time.Time)
+
+	// RetryOnRateLimit makes Do retry a request the API refuses because the
+	// rate limit was exceeded, up to MaxRetries times. Before each retry Do
+	// waits until the rate limit resets, or if the reset time isn't known,
+	// for a backoff that doubles with each attempt.
+	RetryOnRateLimit bool
+
+	// MaxRetries is how many times Do retries a rate limited request when
+	// RetryOnRateLimit is set. Zero means the default of 3; a negative value
+	// means no retries.
+	MaxRetries int
+
+	// Tracer, if set, is used by Do to record a span for every request it
+	// sends, tagged with the request's method and path and the response's
+	// status code.
+	Tracer Tracer
+
+	// MaxResponseBytes limits how much of a response body Do reads. Reading
+	// past the limit fails with ErrResponseTooLarge. Zero means no limit.
+	MaxResponseBytes int64
+
 	// Services used for communicating with the API
-	Actions        *ActionsService
-	Domains        *DomainsService
-	Droplet        *DropletsService
-	DropletActions *DropletActionsService
-	Images         *ImagesService
-	ImageActions   *ImageActionsService
-	Keys           *KeysService
-	Regions        *RegionsService
-	Sizes          *SizesService
+	Account           *AccountService
+	Actions           *ActionsService
+	Billing           *BillingService
+	Domains           *DomainsService
+	DomainRecords     *DomainRecordsService
+	Droplet           *DropletsService
+	DropletActions    *DropletActionsService
+	FloatingIPs       *FloatingIPsService
+	FloatingIPActions *FloatingIPActionsService
+	Images            *ImagesService
+	ImageActions      *ImageActionsService
+	Keys              *KeysService
+	Projects          *ProjectsService
+	Regions           *RegionsService
+	Sizes             *SizesService
+	Storage           *StorageService
+	StorageActions    *StorageActionsService
+	Tags              *TagsService
 }
 
 // ListOptions specifies the optional parameters to various List methods that
@@ -81,16 +150,65 @@ type Response struct {
 	// Monitoring URI
 	Monitor string
 
+	// Meta describes the whole result set of a list request, such as its
+	// total size. It is nil for responses that have none.
+	Meta *Meta
+
+	// Links to actions on the requested resource, for responses that
+	// include them, such as those of DropletsService.Get and Create.
+	Links *Links
+
 	Rate
 }
 
+// Meta describes the result set of a list request.
+type Meta struct {
+	// Total is the number of items in the result set, across all pages.
+	Total int `json:"total"`
+}
+
 // An ErrorResponse reports the error caused by an API request
 type ErrorResponse struct {
 	// HTTP response that caused this error
 	Response *http.Response
 
 	// Error message
-	Message string
+	Message string `json:"message"`
+
+	// Details of the errors, such as which fields of the request were
+	// invalid
+	Errors []ErrorDetail `json:"errors,omitempty"`
+
+	// RequestID is the id the API assigned to the request, which
+	// DigitalOcean support can use to trace it
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// ErrorDetail describes one of the errors reported by an ErrorResponse.
+type ErrorDetail struct {
+	Resource string `json:"resource"`
+	Field    string `json:"field"`
+	Code     string `json:"code"`
+}
+
+// A RateLimitError is returned when a request is refused because the client
+// has exceeded its rate limit. Requests can be made again once Rate.Reset has
+// passed.
+type RateLimitError struct {
+	*ErrorResponse
+
+	// Rate limit reported by the response that caused this error
+	Rate Rate
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%v (rate limit resets at %v)", e.ErrorResponse.Error(), e.Rate.Reset)
+}
+
+// Unwrap returns the underlying *ErrorResponse, so that errors.As still finds
+// it for a rate limited request.
+func (e *RateLimitError) Unwrap() error {
+	return e.ErrorResponse
 }
 
 // Rate contains the rate limit for the current client.
@@ -122,11 +240,20 @@ func addOptions(s string, opt interface{}) (string, error) {
 		return s, err
 	}
 
-	u.RawQuery = qv.Encode()
+	// keep any query, such as a filter, already in s
+	q := u.Query()
+	for k, v := range qv {
+		q[k] = v
+	}
+
+	u.RawQuery = q.Encode()
 	return u.String(), nil
 }
 
-// NewClient returns a new Digital Ocean API client.
+// NewClient returns a new Digital Ocean API client. Requests are sent with
+// httpClient, or http.DefaultClient if it is nil, which must authenticate them:
+// use a client whose Transport is a TokenTransport or an OAuth 2 transport, or
+// call NewClientWithToken instead.
 func NewClient(httpClient *http.Client) *Client {
 	if httpClient == nil {
 		httpClient = http.DefaultClient
@@ -134,47 +261,240 @@ func NewClient(httpClient *http.Client) *Client {
 
 	baseURL, _ := url.Parse(defaultBaseURL)
 
-	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent}
+	c := &Client{
+		client:             httpClient,
+		BaseURL:            baseURL,
+		UserAgent:          userAgent,
+		ActionPollInterval: defaultActionPollInterval,
+	}
+	if debug, _ := strconv.ParseBool(os.Getenv(debugEnv)); debug {
+		c.DebugLog = os.Stderr
+	}
+	c.Account = &AccountService{client: c}
 	c.Actions = &ActionsService{client: c}
+	c.Billing = &BillingService{client: c}
 	c.Domains = &DomainsService{client: c}
+	c.DomainRecords = &DomainRecordsService{client: c}
 	c.Droplet = &DropletsService{client: c}
 	c.DropletActions = &DropletActionsService{client: c}
+	c.FloatingIPs = &FloatingIPsService{client: c}
+	c.FloatingIPActions = &FloatingIPActionsService{client: c}
 	c.Images = &ImagesService{client: c}
 	c.ImageActions = &ImageActionsService{client: c}
 	c.Keys = &KeysService{client: c}
+	c.Projects = &ProjectsService{client: c}
 	c.Regions = &RegionsService{client: c}
 	c.Sizes = &SizesService{client: c}
+	c.Storage = &StorageService{client: c}
+	c.StorageActions = &StorageActionsService{client: c}
+	c.Tags = &TagsService{client: c}
 
 	return c
 }
 
+// NewPooledHTTPClient returns an http.Client whose Transport keeps more idle
+// connections to the API open than http.DefaultTransport does, so that bursts
+// of concurrent requests can reuse connections once they finish instead of
+// opening new ones. Pass it to NewClient, or use its Transport as the base of
+// an authenticating transport.
+//
+// The Transport always attempts HTTP/2, even if its TLS configuration is
+// customized, so concurrent requests are multiplexed over a single connection
+// when the server supports it. The negotiated protocol is available from
+// Response.Proto.
+func NewPooledHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = defaultMaxIdleConnsPerHost
+	transport.IdleConnTimeout = defaultIdleConnTimeout
+	transport.ForceAttemptHTTP2 = true
+
+	return &http.Client{Transport: transport}
+}
+
+// NewProxiedHTTPClient returns an http.Client like NewPooledHTTPClient that
+// sends every request through the HTTP proxy at proxyURL, regardless of the
+// HTTP_PROXY and HTTPS_PROXY environment variables.
+func NewProxiedHTTPClient(proxyURL string) (*http.Client, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := NewPooledHTTPClient()
+	httpClient.Transport.(*http.Transport).Proxy = http.ProxyURL(u)
+
+	return httpClient, nil
+}
+
+// An Option configures a Client made by NewClientWithOptions.
+type Option func(*Client) error
+
+// NewClientWithOptions returns a new Digital Ocean API client configured by
+// opts, which are applied in order. Unlike NewClient, it reports an error if
+// an option is invalid.
+func NewClientWithOptions(opts ...Option) (*Client, error) {
+	c := NewClient(nil)
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// WithBaseURL sets the base URL of API requests, which must be absolute.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		if !u.IsAbs() {
+			return fmt.Errorf("base URL %q is not absolute", baseURL)
+		}
+
+		c.BaseURL = normalizeBaseURL(u)
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with API requests.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) error {
+		c.UserAgent = ua
+		return nil
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send API requests, as described
+// by NewClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) error {
+		if httpClient != nil {
+			c.client = httpClient
+		}
+		return nil
+	}
+}
+
+// NewClientWithToken returns a new Digital Ocean API client that authenticates
+// its requests with a personal access token. Its connections are pooled as
+// described by NewPooledHTTPClient.
+func NewClientWithToken(token string) *Client {
+	transport := &TokenTransport{
+		Token: token,
+		Base:  NewPooledHTTPClient().Transport,
+	}
+
+	return NewClient(&http.Client{Transport: transport})
+}
+
+// TokenTransport is an http.RoundTripper that authenticates requests with a
+// personal access token, sent as a bearer token in the Authorization header.
+type TokenTransport struct {
+	// Token is the personal access token.
+	Token string
+
+	// Base is the transport that sends the authenticated requests. If nil,
+	// http.DefaultTransport is used.
+	Base http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *TokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	// a RoundTripper must not modify the request it's given
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.Token)
+
+	return base.RoundTrip(req)
+}
+
+// NewTestClient returns a Client whose requests never reach the network;
+// instead, responder is called with each request and returns its response.
+// It's intended for testing code built on this package.
+func NewTestClient(responder func(*http.Request) (*http.Response, error)) *Client {
+	return NewClient(&http.Client{Transport: roundTripperFunc(responder)})
+}
+
+// roundTripperFunc adapts a function to the http.RoundTripper interface.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// RateLimit returns a copy of the client's current rate limit, as determined
+// by the most recent API call. It is safe to call while other requests are in
+// progress.
+func (c *Client) RateLimit() Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+
+	return c.Rate
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr, which will be resolved to the
-// BaseURL of the Client. Relative URLS should always be specified without a preceding slash. If specified, the
-// value pointed to by body is JSON encoded and included in as the request body.
+// BaseURL of the Client, which is treated as ending with a slash whether or not it does. Relative URLS should always
+// be specified without a preceding slash, or they replace the path of the BaseURL. If specified, the value pointed
+// to by body is JSON encoded and included in as the request body.
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+	return c.NewRequestWithContext(context.Background(), method, urlStr, body)
+}
+
+// normalizeBaseURL returns u with a trailing slash added to its path if it
+// lacks one, so that relative paths are resolved beneath its last segment
+// rather than replacing it.
+func normalizeBaseURL(u *url.URL) *url.URL {
+	if strings.HasSuffix(u.Path, "/") {
+		return u
+	}
+
+	normalized := *u
+	normalized.Path += "/"
+	if normalized.RawPath != "" {
+		normalized.RawPath += "/"
+	}
+	return &normalized
+}
+
+// NewRequestWithContext creates an API request as NewRequest does, bound to ctx. Cancelling ctx aborts the request
+// when it is sent with Do.
+func (c *Client) NewRequestWithContext(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
 	rel, err := url.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
 
-	u := c.BaseURL.ResolveReference(rel)
+	u := normalizeBaseURL(c.BaseURL).ResolveReference(rel)
 
 	buf := new(bytes.Buffer)
 	if body != nil {
-		err := json.NewEncoder(buf).Encode(body)
+		// user data scripts commonly contain <, > and &, which should be sent
+		// as is rather than HTML escaped
+		enc := json.NewEncoder(buf)
+		enc.SetEscapeHTML(false)
+		err := enc.Encode(body)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+	// http.NewRequestWithContext sets GetBody for a bytes.Buffer, which Do
+	// uses to send the body again when it retries the request
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Add("Content-Type", mediaType)
 	req.Header.Add("Accept", mediaType)
-	req.Header.Add("User-Agent", userAgent)
+	req.Header.Add("User-Agent", c.UserAgent)
 	return req, nil
 }
 
@@ -218,6 +538,23 @@ func (r *Response) populatePageValues() {
 	}
 }
 
+// pageLinks are the page links of a list response body.
+type pageLinks struct {
+	First string `json:"first"`
+	Prev  string `json:"prev"`
+	Next  string `json:"next"`
+	Last  string `json:"last"`
+}
+
+// populatePageValuesFromBody populates the pagination link values in the
+// Response from the links.pages object of a response body.
+func (r *Response) populatePageValuesFromBody(pages *pageLinks) {
+	r.FirstPage = pages.First
+	r.PrevPage = pages.Prev
+	r.NextPage = pages.Next
+	r.LastPage = pages.Last
+}
+
 func (r *Response) populateMonitor() {
 	links, err := r.links()
 
@@ -229,6 +566,30 @@ func (r *Response) populateMonitor() {
 	}
 }
 
+// RequestID returns the id the API assigned to the request, which DigitalOcean
+// support can use to trace it. It is empty if the response has none.
+func (r *Response) RequestID() string {
+	return r.Header.Get(headerRequestID)
+}
+
+// Deprecation returns the value of the Deprecation header, which the API sets
+// on responses from deprecated endpoints. It is empty if the endpoint isn't
+// deprecated.
+func (r *Response) Deprecation() string {
+	return r.Header.Get(headerDeprecation)
+}
+
+// Sunset returns when a deprecated endpoint will stop working, from the Sunset
+// header. ok is false if the response has no valid Sunset header.
+func (r *Response) Sunset() (t time.Time, ok bool) {
+	t, err := http.ParseTime(r.Header.Get(headerSunset))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
 func (r *Response) links() (map[string]headerLink.Link, error) {
 	if linkText, ok := r.Response.Header["Link"]; ok {
 		links, err := headerLink.Parse(linkText[0])
@@ -250,32 +611,172 @@ func (r *Response) links() (map[string]headerLink.Link, error) {
 
 // populateRate parses the rate related headers and populates the response Rate.
 func (r *Response) populateRate() {
+	r.Rate = parseRate(r.Response)
+}
+
+// parseRate parses the rate limit headers of r.
+func parseRate(r *http.Response) Rate {
+	var rate Rate
 	if limit := r.Header.Get(headerRateLimit); limit != "" {
-		r.Rate.Limit, _ = strconv.Atoi(limit)
+		rate.Limit, _ = strconv.Atoi(limit)
 	}
 	if remaining := r.Header.Get(headerRateRemaining); remaining != "" {
-		r.Rate.Remaining, _ = strconv.Atoi(remaining)
+		rate.Remaining, _ = strconv.Atoi(remaining)
 	}
 	if reset := r.Header.Get(headerRateReset); reset != "" {
 		if v, _ := strconv.ParseInt(reset, 10, 64); v != 0 {
-			r.Rate.Reset = Timestamp{time.Unix(v, 0)}
+			rate.Reset = Timestamp{time.Unix(v, 0)}
 		}
 	}
+	return rate
 }
 
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
-// the raw response will be written to v, without attempting to decode it.
+// the raw response will be written to v, without attempting to decode it. If the request's context is cancelled
+// or times out before a response is received, its error is returned.
+//
+// If the client's RetryOnRateLimit is set, a request that is rate limited is retried up to MaxRetries times,
+// waiting for the rate limit to reset before each attempt.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+	ctx := req.Context()
+
+	maxRetries := c.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultMaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		if c.RetryOnRateLimit {
+			// don't spend a request that's certain to be rate limited
+			if rate := c.RateLimit(); rate.Limit > 0 && rate.Remaining == 0 {
+				if err := sleepContext(ctx, rate.ResetIn()); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		response, err := c.do(req, v)
+		if !c.RetryOnRateLimit || attempt >= maxRetries || !isRateLimited(err) {
+			return response, err
+		}
+
+		wait := response.Rate.ResetIn()
+		if wait == 0 {
+			wait = minRetryWait << uint(attempt)
+		}
+		if err := sleepContext(ctx, wait); err != nil {
+			return response, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return response, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// isRateLimited reports whether err is the API refusing a request because the
+// rate limit was exceeded.
+func isRateLimited(err error) bool {
+	_, ok := err.(*RateLimitError)
+	return ok
+}
+
+// sleepContext waits for d, or returns the context's error if ctx is done
+// first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// do sends req once, as described by Do, within a span if the client has a
+// Tracer.
+func (c *Client) do(req *http.Request, v interface{}) (*Response, error) {
+	if c.Tracer == nil {
+		return c.send(req, v)
+	}
+
+	ctx, span := c.Tracer.StartSpan(req.Context(), "HTTP "+req.Method)
+	defer span.End()
+
+	span.SetAttribute(spanAttributeMethod, req.Method)
+	span.SetAttribute(spanAttributePath, req.URL.Path)
+
+	response, err := c.send(req.WithContext(ctx), v)
+	if response != nil {
+		span.SetAttribute(spanAttributeStatusCode, response.StatusCode)
+	}
+
+	return response, err
+}
+
+// send sends req once and handles its response, as described by Do.
+func (c *Client) send(req *http.Request, v interface{}) (*Response, error) {
+	ctx := req.Context()
+
+	if c.DebugLog != nil {
+		if dump, err := httputil.DumpRequestOut(req, true); err == nil {
+			fmt.Fprintf(c.DebugLog, "%s\n", dump)
+		}
+	}
+
 	resp, err := c.client.Do(req)
 	if err != nil {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
 		return nil, err
 	}
 
 	defer resp.Body.Close()
 
+	// limit the body before it's dumped, which reads all of it
+	if c.MaxResponseBytes > 0 {
+		resp.Body = &maxBytesReader{ReadCloser: resp.Body, remaining: c.MaxResponseBytes}
+	}
+
+	if c.DebugLog != nil {
+		dump, err := httputil.DumpResponse(resp, true)
+		if err != nil {
+			// the body is too large, or failed to read; dump the headers,
+			// leaving reading the body to fail again below
+			dump, err = httputil.DumpResponse(resp, false)
+		}
+		if err == nil {
+			fmt.Fprintf(c.DebugLog, "%s\n", dump)
+		}
+	}
+
 	response := newResponse(resp)
+
+	c.rateMu.Lock()
 	c.Rate = response.Rate
+	c.rateMu.Unlock()
+
+	if c.OnDeprecation != nil {
+		sunset, hasSunset := response.Sunset()
+		if hasSunset || response.Deprecation() != "" {
+			c.OnDeprecation(req.URL.Path, sunset)
+		}
+	}
 
 	err = CheckResponse(resp)
 	if err != nil {
@@ -284,22 +785,109 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 
 	if v != nil {
 		if w, ok := v.(io.Writer); ok {
-			io.Copy(w, resp.Body)
+			_, err = io.Copy(w, resp.Body)
 		} else {
-			json.NewDecoder(resp.Body).Decode(v)
+			var data []byte
+			data, err = ioutil.ReadAll(resp.Body)
+			if err != nil {
+				return response, err
+			}
+
+			if len(bytes.TrimSpace(data)) == 0 {
+				return response, nil
+			}
+
+			if err = json.Unmarshal(data, v); err != nil {
+				return response, err
+			}
+
+			// list responses carry a meta block beside the resources, and
+			// may carry their page links in the body rather than in the
+			// Link header; only parse the body again when it has either
+			if bytes.Contains(data, []byte(`"meta"`)) || bytes.Contains(data, []byte(`"links"`)) {
+				envelope := struct {
+					Meta  *Meta `json:"meta"`
+					Links struct {
+						Pages *pageLinks `json:"pages"`
+					} `json:"links"`
+				}{}
+				// v decoded, so the body is valid JSON; a top level meta or
+				// links of another shape is simply not used
+				json.Unmarshal(data, &envelope)
+
+				response.Meta = envelope.Meta
+				if pages := envelope.Links.Pages; pages != nil && response.Header.Get("Link") == "" {
+					response.populatePageValuesFromBody(pages)
+				}
+			}
 		}
 	}
 
 	return response, err
 }
+
+// ErrResponseTooLarge is returned by Do when a response body is larger than
+// the client's MaxResponseBytes.
+var ErrResponseTooLarge = errors.New("godo: response body too large")
+
+// maxBytesReader reads from a response body until remaining bytes have been
+// read, then fails with ErrResponseTooLarge if the body has more to give.
+type maxBytesReader struct {
+	io.ReadCloser
+	remaining int64
+	exceeded  bool
+}
+
+func (r *maxBytesReader) Read(p []byte) (int, error) {
+	if r.exceeded {
+		return 0, ErrResponseTooLarge
+	}
+	if int64(len(p)) > r.remaining+1 {
+		p = p[:r.remaining+1]
+	}
+
+	n, err := r.ReadCloser.Read(p)
+	if int64(n) > r.remaining {
+		n = int(r.remaining)
+		r.remaining = 0
+		r.exceeded = true
+		return n, ErrResponseTooLarge
+	}
+	r.remaining -= int64(n)
+
+	return n, err
+}
+
+// PrevPage requests the page preceding the one in r, decoding the result into
+// v as Do would. It allows iterating backwards through a paginated result set,
+// starting from r.LastPage. If r is already the first page, PrevPage returns a
+// nil Response and a nil error.
+func (c *Client) PrevPage(ctx context.Context, r *Response, v interface{}) (*Response, error) {
+	if r == nil || r.PrevPage == "" {
+		return nil, nil
+	}
+
+	req, err := c.NewRequestWithContext(ctx, "GET", r.PrevPage, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(req, v)
+}
+
 func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %v",
+	msg := fmt.Sprintf("%v %v: %d %v",
 		r.Response.Request.Method, r.Response.Request.URL, r.Response.StatusCode, r.Message)
+	if r.RequestID != "" {
+		msg += fmt.Sprintf(" [%v]", r.RequestID)
+	}
+	return msg
 }
 
 // CheckResponse checks the API response for errors, and returns them if present. A response is considered an
 // error if it has a status code outside the 200 range. API error responses are expected to have either no response
 // body, or a JSON response body that maps to ErrorResponse. Any other response body will be silently ignored.
+// A 429 Too Many Requests response is returned as a *RateLimitError.
 func CheckResponse(r *http.Response) error {
 	if c := r.StatusCode; c >= 200 && c <= 299 {
 		return nil
@@ -308,16 +896,68 @@ func CheckResponse(r *http.Response) error {
 	errorResponse := &ErrorResponse{Response: r}
 	data, err := ioutil.ReadAll(r.Body)
 	if err == nil && len(data) > 0 {
-		json.Unmarshal(data, errorResponse)
+		if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+			errorResponse.Message = arrayErrorMessage(trimmed)
+		} else {
+			json.Unmarshal(data, errorResponse)
+		}
+	}
+
+	if id := r.Header.Get(headerRequestID); id != "" {
+		errorResponse.RequestID = id
+	}
+
+	if r.StatusCode == http.StatusTooManyRequests {
+		return &RateLimitError{ErrorResponse: errorResponse, Rate: parseRate(r)}
 	}
 
 	return errorResponse
 }
 
+// arrayErrorMessage builds an error message from an error body that is a JSON
+// array rather than an object. Elements are joined with "; ", using strings as
+// is, the message of objects that have one, and the raw JSON otherwise.
+func arrayErrorMessage(data []byte) string {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return ""
+	}
+
+	messages := make([]string, 0, len(elements))
+	for _, element := range elements {
+		var str string
+		if json.Unmarshal(element, &str) == nil {
+			messages = append(messages, str)
+			continue
+		}
+
+		var obj struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(element, &obj) == nil && obj.Message != "" {
+			messages = append(messages, obj.Message)
+			continue
+		}
+
+		messages = append(messages, string(element))
+	}
+
+	return strings.Join(messages, "; ")
+}
+
 func (r Rate) String() string {
 	return Stringify(r)
 }
 
+// ResetIn returns how long until the rate limit resets. It is never negative.
+func (r Rate) ResetIn() time.Duration {
+	if d := time.Until(r.Reset.Time); d > 0 {
+		return d
+	}
+
+	return 0
+}
+
 // String is a helper routine that allocates a new string value
 // to store v and returns a pointer to it.
 func String(v string) *string {
@@ -1,14 +1,20 @@
 package godo
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -19,6 +25,8 @@ var (
 	client *Client
 
 	server *httptest.Server
+
+	ctx = context.TODO()
 )
 
 func setup() {
@@ -72,6 +80,250 @@ func TestNewClient(t *testing.T) {
 	if c.UserAgent != userAgent {
 		t.Errorf("NewClick UserAgent = %v, expected %v", c.UserAgent, userAgent)
 	}
+
+	if c.ActionPollInterval != defaultActionPollInterval {
+		t.Errorf("NewClient ActionPollInterval = %v, expected %v", c.ActionPollInterval, defaultActionPollInterval)
+	}
+}
+
+func TestNewClientWithOptions(t *testing.T) {
+	httpClient := &http.Client{}
+	c, err := NewClientWithOptions(
+		WithBaseURL("https://api.example.com/digitalocean"),
+		WithUserAgent("example/1.0"),
+		WithHTTPClient(httpClient),
+	)
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if expected := "https://api.example.com/digitalocean/"; c.BaseURL.String() != expected {
+		t.Errorf("NewClientWithOptions BaseURL = %v, expected %v", c.BaseURL, expected)
+	}
+	if expected := "example/1.0"; c.UserAgent != expected {
+		t.Errorf("NewClientWithOptions UserAgent = %v, expected %v", c.UserAgent, expected)
+	}
+	req, _ := c.NewRequest("GET", "v2/droplets", nil)
+	if got, expected := req.Header.Get("User-Agent"), "example/1.0"; got != expected {
+		t.Errorf("NewRequest() User-Agent = %v, expected %v", got, expected)
+	}
+	if c.client != httpClient {
+		t.Errorf("NewClientWithOptions HTTP client = %v, expected %v", c.client, httpClient)
+	}
+	if c.Droplet == nil || c.Droplet.client != c {
+		t.Error("NewClientWithOptions did not set up the services")
+	}
+}
+
+func TestNewClientWithOptions_defaults(t *testing.T) {
+	c, err := NewClientWithOptions()
+	if err != nil {
+		t.Fatalf("NewClientWithOptions returned error: %v", err)
+	}
+
+	if c.BaseURL.String() != defaultBaseURL {
+		t.Errorf("NewClientWithOptions BaseURL = %v, expected %v", c.BaseURL, defaultBaseURL)
+	}
+	if c.UserAgent != userAgent {
+		t.Errorf("NewClientWithOptions UserAgent = %v, expected %v", c.UserAgent, userAgent)
+	}
+	if c.client != http.DefaultClient {
+		t.Errorf("NewClientWithOptions HTTP client = %v, expected http.DefaultClient", c.client)
+	}
+}
+
+func TestWithBaseURL_invalid(t *testing.T) {
+	for _, baseURL := range []string{"%zz", "://api.digitalocean.com", "api.digitalocean.com"} {
+		if _, err := NewClientWithOptions(WithBaseURL(baseURL)); err == nil {
+			t.Errorf("NewClientWithOptions(WithBaseURL(%q)) returned no error", baseURL)
+		}
+	}
+}
+
+func TestNewClient_debugEnv(t *testing.T) {
+	t.Setenv("GODO_DEBUG", "1")
+
+	c := NewClient(nil)
+	if c.DebugLog != os.Stderr {
+		t.Errorf("NewClient DebugLog = %v, expected os.Stderr", c.DebugLog)
+	}
+}
+
+func TestNewClient_debugEnvUnset(t *testing.T) {
+	for _, v := range []string{"", "0", "false", "no"} {
+		t.Setenv("GODO_DEBUG", v)
+
+		c := NewClient(nil)
+		if c.DebugLog != nil {
+			t.Errorf("NewClient DebugLog with GODO_DEBUG=%q = %v, expected nil", v, c.DebugLog)
+		}
+	}
+}
+
+func TestNewClient_services(t *testing.T) {
+	c := NewClient(nil)
+
+	services := map[string]interface{}{
+		"Account":           c.Account,
+		"Actions":           c.Actions,
+		"Billing":           c.Billing,
+		"Domains":           c.Domains,
+		"DomainRecords":     c.DomainRecords,
+		"Droplet":           c.Droplet,
+		"DropletActions":    c.DropletActions,
+		"FloatingIPs":       c.FloatingIPs,
+		"FloatingIPActions": c.FloatingIPActions,
+		"Images":            c.Images,
+		"ImageActions":      c.ImageActions,
+		"Keys":              c.Keys,
+		"Projects":          c.Projects,
+		"Regions":           c.Regions,
+		"Sizes":             c.Sizes,
+		"Storage":           c.Storage,
+		"StorageActions":    c.StorageActions,
+		"Tags":              c.Tags,
+	}
+
+	for name, service := range services {
+		if reflect.ValueOf(service).IsNil() {
+			t.Errorf("NewClient %v service is nil", name)
+		}
+	}
+
+	if c.DropletActions.client != c {
+		t.Errorf("NewClient DropletActions is not wired to the client")
+	}
+}
+
+func TestNewPooledHTTPClient(t *testing.T) {
+	c := NewPooledHTTPClient()
+
+	transport, ok := c.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("NewPooledHTTPClient Transport = %T, expected *http.Transport", c.Transport)
+	}
+
+	if transport.MaxIdleConnsPerHost != defaultMaxIdleConnsPerHost {
+		t.Errorf("MaxIdleConnsPerHost = %v, expected %v", transport.MaxIdleConnsPerHost, defaultMaxIdleConnsPerHost)
+	}
+
+	if transport.IdleConnTimeout != defaultIdleConnTimeout {
+		t.Errorf("IdleConnTimeout = %v, expected %v", transport.IdleConnTimeout, defaultIdleConnTimeout)
+	}
+}
+
+func TestNewPooledHTTPClient_http2(t *testing.T) {
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"droplets":[{"id":1}]}`)
+	}))
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	defer ts.Close()
+
+	httpClient := NewPooledHTTPClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.TLSClientConfig = ts.Client().Transport.(*http.Transport).TLSClientConfig.Clone()
+
+	c := NewClient(httpClient)
+	c.BaseURL, _ = url.Parse(ts.URL + "/")
+
+	req, _ := c.NewRequest("GET", "v2/droplets", nil)
+	root := new(dropletsRoot)
+	resp, err := c.Do(req, root)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	if resp.ProtoMajor != 2 {
+		t.Errorf("Response.Proto = %v, expected HTTP/2", resp.Proto)
+	}
+
+	expected := []Droplet{{ID: 1}}
+	if !reflect.DeepEqual(root.Droplets, expected) {
+		t.Errorf("Response body = %+v, expected %+v", root.Droplets, expected)
+	}
+}
+
+func TestNewProxiedHTTPClient(t *testing.T) {
+	var proxied *http.Request
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r
+		fmt.Fprint(w, `{"droplets":[{"id":1}]}`)
+	}))
+	defer proxy.Close()
+
+	httpClient, err := NewProxiedHTTPClient(proxy.URL)
+	if err != nil {
+		t.Fatalf("NewProxiedHTTPClient returned error: %v", err)
+	}
+
+	c := NewClient(httpClient)
+	c.BaseURL, _ = url.Parse("http://api.digitalocean.invalid/")
+
+	req, _ := c.NewRequest("GET", "v2/droplets", nil)
+	if _, err := c.Do(req, new(dropletsRoot)); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	if proxied == nil {
+		t.Fatal("Request was not sent through the proxy")
+	}
+	if expected := "http://api.digitalocean.invalid/v2/droplets"; proxied.RequestURI != expected {
+		t.Errorf("Proxied RequestURI = %v, expected %v", proxied.RequestURI, expected)
+	}
+}
+
+func TestNewProxiedHTTPClient_badURL(t *testing.T) {
+	_, err := NewProxiedHTTPClient(":")
+	testURLParseError(t, err)
+}
+
+func TestNewTestClient(t *testing.T) {
+	c := NewTestClient(func(r *http.Request) (*http.Response, error) {
+		if expected := "/v2/droplets/12345"; r.URL.Path != expected {
+			t.Errorf("Request path = %v, expected %v", r.URL.Path, expected)
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {mediaType}},
+			Body:       ioutil.NopCloser(strings.NewReader(`{"droplet":{"id":12345}}`)),
+			Request:    r,
+		}, nil
+	})
+
+	droplet, _, err := c.Droplet.Get(ctx, 12345)
+	if err != nil {
+		t.Fatalf("Droplets.Get returned error: %v", err)
+	}
+
+	expected := &Droplet{ID: 12345}
+	if !reflect.DeepEqual(droplet, expected) {
+		t.Errorf("Droplets.Get returned %+v, expected %+v", droplet, expected)
+	}
+}
+
+func TestNewClientWithToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if got, expected := r.Header.Get("Authorization"), "Bearer mytoken"; got != expected {
+			t.Errorf("Authorization header = %q, expected %q", got, expected)
+		}
+	})
+
+	c := NewClientWithToken("mytoken")
+	c.BaseURL = client.BaseURL
+
+	req, _ := c.NewRequest("GET", "/", nil)
+	if _, err := c.Do(req, nil); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	if req.Header.Get("Authorization") != "" {
+		t.Error("TokenTransport modified the request it was given")
+	}
 }
 
 func TestNewRequest(t *testing.T) {
@@ -93,9 +345,52 @@ func TestNewRequest(t *testing.T) {
 	}
 
 	// test default user-agent is attached to the request
-	userAgent := req.Header.Get("User-Agent")
-	if c.UserAgent != userAgent {
-		t.Errorf("NewRequest() User-Agent = %v, expected %v", userAgent, c.UserAgent)
+	if got := req.Header.Get("User-Agent"); got != userAgent {
+		t.Errorf("NewRequest() User-Agent = %v, expected %v", got, userAgent)
+	}
+}
+
+func TestNewRequest_baseURLTrailingSlash(t *testing.T) {
+	tests := []struct {
+		baseURL, expected string
+	}{
+		{"https://proxy/api", "https://proxy/api/v2/droplets"},
+		{"https://proxy/api/", "https://proxy/api/v2/droplets"},
+		{"https://proxy", "https://proxy/v2/droplets"},
+	}
+
+	for _, tt := range tests {
+		c := NewClient(nil)
+		c.BaseURL, _ = url.Parse(tt.baseURL)
+
+		req, _ := c.NewRequest("GET", "v2/droplets", nil)
+		if req.URL.String() != tt.expected {
+			t.Errorf("NewRequest with BaseURL %v URL = %v, expected %v", tt.baseURL, req.URL, tt.expected)
+		}
+
+		if c.BaseURL.String() != tt.baseURL {
+			t.Errorf("NewRequest modified BaseURL to %v, expected %v", c.BaseURL, tt.baseURL)
+		}
+	}
+}
+
+func TestNewRequest_getBody(t *testing.T) {
+	c := NewClient(nil)
+
+	req, _ := c.NewRequest("POST", "/", map[string]string{"name": "l"})
+	ioutil.ReadAll(req.Body)
+
+	if req.GetBody == nil {
+		t.Fatal("NewRequest did not set GetBody")
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		t.Fatalf("GetBody returned error: %v", err)
+	}
+
+	got, _ := ioutil.ReadAll(body)
+	if expected := `{"name":"l"}` + "\n"; string(got) != expected {
+		t.Errorf("GetBody returned %q, expected %q", got, expected)
 	}
 }
 
@@ -103,7 +398,7 @@ func TestNewRequest_invalidJSON(t *testing.T) {
 	c := NewClient(nil)
 
 	type T struct {
-		A map[int]interface{}
+		A chan int
 	}
 	_, err := c.NewRequest("GET", "/", &T{})
 
@@ -115,6 +410,31 @@ func TestNewRequest_invalidJSON(t *testing.T) {
 	}
 }
 
+func TestNewRequest_unescapedHTML(t *testing.T) {
+	c := NewClient(nil)
+
+	inBody := &DropletCreateRequest{Name: "l", UserData: "#!/bin/bash\necho <b>&</b> > /tmp/out"}
+	outBody := `{"name":"l","region":"","size":"","image":"","ssh_keys":null,` +
+		`"user_data":"#!/bin/bash\necho <b>&</b> > /tmp/out"}` + "\n"
+	req, _ := c.NewRequest("POST", "/foo", inBody)
+
+	body, _ := ioutil.ReadAll(req.Body)
+	if string(body) != outBody {
+		t.Errorf("NewRequest(%v) Body = %v, expected %v", inBody, string(body), outBody)
+	}
+}
+
+func TestAddOptions_existingQuery(t *testing.T) {
+	path, err := addOptions("v2/droplets?tag_name=web", &ListOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("addOptions returned error: %v", err)
+	}
+
+	if expected := "v2/droplets?page=2&tag_name=web"; path != expected {
+		t.Errorf("addOptions returned %v, expected %v", path, expected)
+	}
+}
+
 func TestNewRequest_badURL(t *testing.T) {
 	c := NewClient(nil)
 	_, err := c.NewRequest("GET", ":", nil)
@@ -146,6 +466,215 @@ func TestDo(t *testing.T) {
 	}
 }
 
+func TestDo_decodeError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(req, new(struct{ A string }))
+	if err == nil {
+		t.Error("Expected an error decoding a truncated body")
+	}
+}
+
+func TestDo_emptyBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	req, _ := client.NewRequest("DELETE", "/", nil)
+	_, err := client.Do(req, new(struct{ A string }))
+	if err != nil {
+		t.Errorf("Do returned error for an empty body: %v", err)
+	}
+}
+
+func TestDo_debugLog(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var log bytes.Buffer
+	client.DebugLog = &log
+
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/foo", nil)
+	body := new(struct{ A string })
+	_, err := client.Do(req, body)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+
+	if body.A != "a" {
+		t.Errorf("Response body = %+v, expected A to be \"a\"", body)
+	}
+	if !strings.Contains(log.String(), "GET /foo") || !strings.Contains(log.String(), `{"A":"a"}`) {
+		t.Errorf("DebugLog = %q, expected the request and response", log.String())
+	}
+}
+
+func TestDo_onDeprecation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var path string
+	var sunset time.Time
+	calls := 0
+	client.OnDeprecation = func(p string, s time.Time) {
+		calls++
+		path, sunset = p, s
+	}
+
+	mux.HandleFunc("/v2/old", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Sunset", "Sat, 31 Dec 2016 23:59:59 GMT")
+	})
+	mux.HandleFunc("/v2/new", func(w http.ResponseWriter, r *http.Request) {})
+
+	req, _ := client.NewRequest("GET", "v2/new", nil)
+	client.Do(req, nil)
+	if calls != 0 {
+		t.Errorf("OnDeprecation called %d times for an endpoint that isn't deprecated", calls)
+	}
+
+	req, _ = client.NewRequest("GET", "v2/old", nil)
+	client.Do(req, nil)
+	if calls != 1 {
+		t.Fatalf("OnDeprecation called %d times, expected 1", calls)
+	}
+
+	if expected := "/v2/old"; path != expected {
+		t.Errorf("OnDeprecation path = %v, expected %v", path, expected)
+	}
+	if expected := time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC); !sunset.Equal(expected) {
+		t.Errorf("OnDeprecation sunset = %v, expected %v", sunset, expected)
+	}
+}
+
+func TestDo_onDeprecation_noSunset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	called := false
+	client.OnDeprecation = func(p string, s time.Time) {
+		called = true
+		if !s.IsZero() {
+			t.Errorf("OnDeprecation sunset = %v, expected the zero Time", s)
+		}
+	}
+
+	mux.HandleFunc("/v2/old", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "true")
+	})
+
+	req, _ := client.NewRequest("GET", "v2/old", nil)
+	client.Do(req, nil)
+	if !called {
+		t.Error("OnDeprecation was not called for a Deprecation header")
+	}
+}
+
+func TestDo_meta(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"droplets":[{"id":1},{"id":2}],"meta":{"total":42}}`)
+	})
+
+	droplets, resp, err := client.Droplet.List(ctx, &ListOptions{PerPage: 2})
+	if err != nil {
+		t.Fatalf("Droplets.List returned error: %v", err)
+	}
+
+	if expected := []Droplet{{ID: 1}, {ID: 2}}; !reflect.DeepEqual(droplets, expected) {
+		t.Errorf("Droplets.List returned %+v, expected %+v", droplets, expected)
+	}
+	if expected := (&Meta{Total: 42}); !reflect.DeepEqual(resp.Meta, expected) {
+		t.Errorf("Response.Meta = %+v, expected %+v", resp.Meta, expected)
+	}
+}
+
+func TestDo_noMeta(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"droplet":{"id":1}}`)
+	})
+
+	_, resp, err := client.Droplet.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("Droplets.Get returned error: %v", err)
+	}
+
+	if resp.Meta != nil {
+		t.Errorf("Response.Meta = %+v, expected nil", resp.Meta)
+	}
+}
+
+func TestDo_bodyPageLinks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"droplets":[{"id":3}],"links":{"pages":{`+
+			`"first":"https://api.digitalocean.com/v2/droplets?page=1",`+
+			`"prev":"https://api.digitalocean.com/v2/droplets?page=1",`+
+			`"next":"https://api.digitalocean.com/v2/droplets?page=3",`+
+			`"last":"https://api.digitalocean.com/v2/droplets?page=4"}}}`)
+	})
+
+	_, resp, err := client.Droplet.List(ctx, &ListOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("Droplets.List returned error: %v", err)
+	}
+
+	links := map[string]string{
+		"FirstPage": resp.FirstPage,
+		"PrevPage":  resp.PrevPage,
+		"NextPage":  resp.NextPage,
+		"LastPage":  resp.LastPage,
+	}
+	expected := map[string]string{
+		"FirstPage": "https://api.digitalocean.com/v2/droplets?page=1",
+		"PrevPage":  "https://api.digitalocean.com/v2/droplets?page=1",
+		"NextPage":  "https://api.digitalocean.com/v2/droplets?page=3",
+		"LastPage":  "https://api.digitalocean.com/v2/droplets?page=4",
+	}
+	if !reflect.DeepEqual(links, expected) {
+		t.Errorf("Response page links = %v, expected %v", links, expected)
+	}
+}
+
+func TestDo_bodyPageLinks_linkHeaderPreferred(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `<https://api.digitalocean.com/v2/droplets?page=2>; rel="next"`)
+		fmt.Fprint(w, `{"droplets":[{"id":1}],"links":{"pages":{`+
+			`"next":"https://api.digitalocean.com/v2/droplets?page=9"}}}`)
+	})
+
+	_, resp, err := client.Droplet.List(ctx, nil)
+	if err != nil {
+		t.Fatalf("Droplets.List returned error: %v", err)
+	}
+
+	if expected := "https://api.digitalocean.com/v2/droplets?page=2"; resp.NextPage != expected {
+		t.Errorf("Response.NextPage = %v, expected %v", resp.NextPage, expected)
+	}
+}
+
 func TestDo_httpError(t *testing.T) {
 	setup()
 	defer teardown()
@@ -162,6 +691,117 @@ func TestDo_httpError(t *testing.T) {
 	}
 }
 
+func TestNewRequestWithContext(t *testing.T) {
+	c := NewClient(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, _ := c.NewRequestWithContext(ctx, "GET", "/foo", nil)
+	if req.Context() != ctx {
+		t.Errorf("NewRequestWithContext() Context = %v, expected %v", req.Context(), ctx)
+	}
+}
+
+func TestDo_contextCancelled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	received := make(chan struct{})
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		close(received)
+		<-r.Context().Done()
+	})
+
+	go func() {
+		<-received
+		cancel()
+	}()
+
+	req, _ := client.NewRequestWithContext(ctx, "GET", "/", nil)
+	_, err := client.Do(req, nil)
+
+	if err != context.Canceled {
+		t.Errorf("Expected context.Canceled; got %#v.", err)
+	}
+}
+
+func TestDo_maxResponseBytes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.MaxResponseBytes = 8
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"a very long value"}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(req, new(struct{ A string }))
+
+	if err != ErrResponseTooLarge {
+		t.Errorf("Expected ErrResponseTooLarge; got %#v.", err)
+	}
+
+	req, _ = client.NewRequest("GET", "/", nil)
+	_, err = client.Do(req, new(bytes.Buffer))
+
+	if err != ErrResponseTooLarge {
+		t.Errorf("Expected ErrResponseTooLarge writing to an io.Writer; got %#v.", err)
+	}
+}
+
+func TestDo_maxResponseBytes_debugLog(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var log bytes.Buffer
+	client.DebugLog = &log
+	client.MaxResponseBytes = 8
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"A":"a very long value"}`)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(req, new(struct{ A string }))
+
+	if err != ErrResponseTooLarge {
+		t.Errorf("Expected ErrResponseTooLarge; got %#v.", err)
+	}
+	if strings.Contains(log.String(), "a very long value") {
+		t.Errorf("DebugLog contains the body past MaxResponseBytes:\n%s", log.String())
+	}
+	if !strings.Contains(log.String(), "200 OK") {
+		t.Errorf("DebugLog is missing the response headers:\n%s", log.String())
+	}
+}
+
+func TestDo_maxResponseBytes_withinLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	body := `{"A":"a"}`
+	client.MaxResponseBytes = int64(len(body))
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	v := new(struct{ A string })
+	_, err := client.Do(req, v)
+
+	if err != nil {
+		t.Errorf("Do returned error: %v", err)
+	}
+	if v.A != "a" {
+		t.Errorf("Response body = %+v, expected A to be \"a\"", v)
+	}
+}
+
 // Test handling of an error caused by the internal http client's Do()
 // function.
 func TestDo_redirectLoop(t *testing.T) {
@@ -199,6 +839,7 @@ func TestCheckResponse(t *testing.T) {
 	expected := &ErrorResponse{
 		Response: res,
 		Message:  "m",
+		Errors:   []ErrorDetail{{Resource: "r", Field: "f", Code: "c"}},
 	}
 	if !reflect.DeepEqual(err, expected) {
 		t.Errorf("Error = %#v, expected %#v", err, expected)
@@ -227,11 +868,102 @@ func TestCheckResponse_noBody(t *testing.T) {
 	}
 }
 
+func TestCheckResponse_arrayBody(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusBadRequest,
+		Body: ioutil.NopCloser(strings.NewReader(` ["name is required",
+			{"message":"size is invalid"}, 42]`)),
+	}
+	err := CheckResponse(res).(*ErrorResponse)
+
+	expected := &ErrorResponse{
+		Response: res,
+		Message:  "name is required; size is invalid; 42",
+	}
+	if !reflect.DeepEqual(err, expected) {
+		t.Errorf("Error = %#v, expected %#v", err, expected)
+	}
+}
+
+func TestCheckResponse_rateLimit(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"id":"too_many_requests","message":"API Rate limit exceeded."}`)),
+	}
+	res.Header.Set(headerRateLimit, "5000")
+	res.Header.Set(headerRateRemaining, "0")
+	res.Header.Set(headerRateReset, "1372700873")
+	err, ok := CheckResponse(res).(*RateLimitError)
+	if !ok {
+		t.Fatalf("Expected a *RateLimitError, got %#v", err)
+	}
+
+	if expected := "API Rate limit exceeded."; err.Message != expected {
+		t.Errorf("Error message = %q, expected %q", err.Message, expected)
+	}
+
+	expected := Rate{
+		Limit:     5000,
+		Remaining: 0,
+		Reset:     Timestamp{time.Date(2013, 7, 1, 17, 47, 53, 0, time.UTC)},
+	}
+	if err.Rate.Limit != expected.Limit || err.Rate.Remaining != expected.Remaining || !err.Rate.Reset.Equal(expected.Reset) {
+		t.Errorf("Error rate = %v, expected %v", err.Rate, expected)
+	}
+}
+
+func TestCheckResponse_rateLimitErrorResponse(t *testing.T) {
+	res := &http.Response{
+		Request:    &http.Request{},
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"id":"too_many_requests","message":"API Rate limit exceeded."}`)),
+	}
+
+	var errResp *ErrorResponse
+	if err := CheckResponse(res); !errors.As(err, &errResp) {
+		t.Fatalf("Expected errors.As to find an *ErrorResponse in %#v", err)
+	}
+	if errResp.Response.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("ErrorResponse status = %d, expected %d", errResp.Response.StatusCode, http.StatusTooManyRequests)
+	}
+}
+
 func TestErrorResponse_Error(t *testing.T) {
-	res := &http.Response{Request: &http.Request{}}
+	u, _ := url.Parse("https://api.digitalocean.com/v2/droplets/1")
+	res := &http.Response{
+		Request:    &http.Request{Method: "DELETE", URL: u},
+		StatusCode: http.StatusNotFound,
+	}
 	err := ErrorResponse{Message: "m", Response: res}
-	if err.Error() == "" {
-		t.Errorf("Expected non-empty ErrorResponse.Error()")
+
+	expected := "DELETE https://api.digitalocean.com/v2/droplets/1: 404 m"
+	if err.Error() != expected {
+		t.Errorf("ErrorResponse.Error() = %q, expected %q", err.Error(), expected)
+	}
+}
+
+func TestErrorResponse_Error_requestID(t *testing.T) {
+	u, _ := url.Parse("https://api.digitalocean.com/v2/droplets")
+	res := &http.Response{
+		Request:    &http.Request{Method: "GET", URL: u},
+		StatusCode: http.StatusBadRequest,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"message":"m"}`)),
+	}
+	res.Header.Set("x-request-id", "4d9d8375-3c56-4925-a3e7-eceafdf1c1e2")
+
+	err := CheckResponse(res).(*ErrorResponse)
+	if expected := "4d9d8375-3c56-4925-a3e7-eceafdf1c1e2"; err.RequestID != expected {
+		t.Errorf("ErrorResponse.RequestID = %q, expected %q", err.RequestID, expected)
+	}
+
+	expected := "GET https://api.digitalocean.com/v2/droplets: 400 m [4d9d8375-3c56-4925-a3e7-eceafdf1c1e2]"
+	if err.Error() != expected {
+		t.Errorf("ErrorResponse.Error() = %q, expected %q", err.Error(), expected)
 	}
 }
 
@@ -300,6 +1032,218 @@ func TestDo_rateLimit_errorResponse(t *testing.T) {
 	}
 }
 
+func TestDo_retryOnRateLimit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryOnRateLimit = true
+	client.MaxRetries = 2
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Add(headerRateLimit, "60")
+			w.Header().Add(headerRateRemaining, "0")
+			w.Header().Add(headerRateReset, fmt.Sprint(time.Now().Unix()))
+			http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	type foo struct {
+		A string
+	}
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	body := new(foo)
+	_, err := client.Do(req, body)
+	if err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("Do made %d requests, expected 2", calls)
+	}
+
+	expected := &foo{"a"}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Response body = %v, expected %v", body, expected)
+	}
+}
+
+func TestDo_retryOnRateLimit_defaultMaxRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryOnRateLimit = true
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= 2 {
+			http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+		}
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("Do made %d requests, expected 3", calls)
+	}
+}
+
+func TestDo_retryOnRateLimit_disabled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(req, nil)
+
+	if !isRateLimited(err) {
+		t.Errorf("Expected a rate limit error, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Do made %d requests, expected 1", calls)
+	}
+}
+
+func TestDo_retryOnRateLimit_maxRetries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryOnRateLimit = true
+	client.MaxRetries = 1
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+	})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	_, err := client.Do(req, nil)
+
+	if !isRateLimited(err) {
+		t.Errorf("Expected a rate limit error, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Do made %d requests, expected 2", calls)
+	}
+}
+
+func TestDo_retryOnRateLimit_requestBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryOnRateLimit = true
+	client.MaxRetries = 1
+
+	var bodies []string
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+		}
+	})
+
+	req, _ := client.NewRequest("POST", "/", map[string]string{"name": "example"})
+	if _, err := client.Do(req, nil); err != nil {
+		t.Fatalf("Do(): %v", err)
+	}
+
+	body := `{"name":"example"}` + "\n"
+	if expected := []string{body, body}; !reflect.DeepEqual(bodies, expected) {
+		t.Errorf("Request bodies = %q, expected %q", bodies, expected)
+	}
+}
+
+func TestDo_retryOnRateLimit_contextCancelled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.RetryOnRateLimit = true
+	client.MaxRetries = 1
+
+	calls := 0
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Add(headerRateLimit, "60")
+		w.Header().Add(headerRateRemaining, "0")
+		w.Header().Add(headerRateReset, fmt.Sprint(time.Now().Add(time.Hour).Unix()))
+		http.Error(w, "Too Many Requests", http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := client.NewRequestWithContext(ctx, "GET", "/", nil)
+	_, err := client.Do(req, nil)
+
+	if err != context.DeadlineExceeded {
+		t.Errorf("Expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if calls != 1 {
+		t.Errorf("Do made %d requests, expected 1", calls)
+	}
+}
+
+func TestRate_ResetIn(t *testing.T) {
+	rate := Rate{Reset: Timestamp{time.Now().Add(time.Minute)}}
+	if d := rate.ResetIn(); d <= 59*time.Second || d > time.Minute {
+		t.Errorf("Rate.ResetIn = %v, expected about %v", d, time.Minute)
+	}
+
+	rate = Rate{Reset: Timestamp{time.Now().Add(-time.Minute)}}
+	if d := rate.ResetIn(); d != 0 {
+		t.Errorf("Rate.ResetIn = %v, expected 0 for a reset in the past", d)
+	}
+}
+
+func TestClient_RateLimit_concurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add(headerRateLimit, "60")
+		w.Header().Add(headerRateRemaining, "59")
+		w.Header().Add(headerRateReset, "1372700873")
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				req, _ := client.NewRequest("GET", "/", nil)
+				client.Do(req, nil)
+				client.RateLimit()
+			}
+		}()
+	}
+	wg.Wait()
+
+	rate := client.RateLimit()
+	if expected := 60; rate.Limit != expected {
+		t.Errorf("Client.RateLimit().Limit = %v, expected %v", rate.Limit, expected)
+	}
+	if expected := 59; rate.Remaining != expected {
+		t.Errorf("Client.RateLimit().Remaining = %v, expected %v", rate.Remaining, expected)
+	}
+}
+
 func TestResponse_populatePageValues(t *testing.T) {
 	r := http.Response{
 		Header: http.Header{
@@ -367,3 +1311,105 @@ func TestResponse_populatePageValues_invalid(t *testing.T) {
 		},
 	}
 }
+
+func TestResponse_headers(t *testing.T) {
+	r := http.Response{Header: http.Header{}}
+	r.Header.Set("X-Request-Id", "d8b4b0a1-5b5e-4b8f-a6a1-2f6e1c1f0f3a")
+	r.Header.Set("Deprecation", "true")
+	r.Header.Set("Sunset", "Sat, 31 Dec 2016 23:59:59 GMT")
+
+	response := newResponse(&r)
+
+	if expected := "d8b4b0a1-5b5e-4b8f-a6a1-2f6e1c1f0f3a"; response.RequestID() != expected {
+		t.Errorf("response.RequestID() = %v, expected %v", response.RequestID(), expected)
+	}
+	if expected := "true"; response.Deprecation() != expected {
+		t.Errorf("response.Deprecation() = %v, expected %v", response.Deprecation(), expected)
+	}
+
+	sunset, ok := response.Sunset()
+	if expected := time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC); !ok || !sunset.Equal(expected) {
+		t.Errorf("response.Sunset() = %v, %v, expected %v, true", sunset, ok, expected)
+	}
+}
+
+func TestResponse_headersMissing(t *testing.T) {
+	response := newResponse(&http.Response{Header: http.Header{}})
+
+	if response.RequestID() != "" || response.Deprecation() != "" {
+		t.Errorf("response.RequestID() = %q, response.Deprecation() = %q, expected both empty",
+			response.RequestID(), response.Deprecation())
+	}
+	if _, ok := response.Sunset(); ok {
+		t.Error("response.Sunset() ok = true, expected false")
+	}
+}
+
+func TestClient_PrevPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page > 1 {
+			w.Header().Add("Link", fmt.Sprintf(`<%s/v2/droplets?page=%d>; rel="prev"`, server.URL, page-1))
+		}
+		fmt.Fprintf(w, `{"droplets":[{"id":%d}]}`, page)
+	})
+
+	req, _ := client.NewRequest("GET", "v2/droplets?page=3", nil)
+	root := new(dropletsRoot)
+	resp, err := client.Do(req, root)
+
+	var ids []int
+	for resp != nil {
+		if err != nil {
+			t.Fatalf("Client.PrevPage returned error: %v", err)
+		}
+
+		ids = append(ids, root.Droplets[0].ID)
+
+		root = new(dropletsRoot)
+		resp, err = client.PrevPage(ctx, resp, root)
+	}
+
+	expected := []int{3, 2, 1}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Client.PrevPage visited %v, expected %v", ids, expected)
+	}
+}
+
+func TestClient_PrevPage_firstPage(t *testing.T) {
+	resp, err := NewClient(nil).PrevPage(ctx, &Response{}, nil)
+	if resp != nil || err != nil {
+		t.Errorf("Client.PrevPage returned %v, %v, expected nil, nil", resp, err)
+	}
+}
+
+func benchmarkGets(b *testing.B, httpClient *http.Client) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"droplets":[{"id":1}]}`)
+	}))
+	defer ts.Close()
+
+	c := NewClient(httpClient)
+	c.BaseURL, _ = url.Parse(ts.URL)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		req, _ := c.NewRequest("GET", "v2/droplets", nil)
+		if _, err := c.Do(req, new(dropletsRoot)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDo_defaultHTTPClient(b *testing.B) {
+	benchmarkGets(b, &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()})
+}
+
+func BenchmarkDo_pooledHTTPClient(b *testing.B) {
+	benchmarkGets(b, NewPooledHTTPClient())
+}
@@ -0,0 +1,267 @@
+package godo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// DomainRecordsService handles communication with the domain record related
+// methods of the DigitalOcean API.
+type DomainRecordsService struct {
+	client *Client
+}
+
+type DomainRecordRoot struct {
+	DomainRecord *DomainRecord `json:"domain_record"`
+}
+
+type DomainRecordsRoot struct {
+	DomainRecords []DomainRecord `json:"domain_records"`
+}
+
+// DomainRecord represents a DigitalOcean DomainRecord. Priority, Port and
+// Weight are only set for the record types that use them.
+type DomainRecord struct {
+	ID       int    `json:"id,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Data     string `json:"data,omitempty"`
+	Priority *int   `json:"priority,omitempty"`
+	Port     *int   `json:"port,omitempty"`
+	Weight   *int   `json:"weight,omitempty"`
+}
+
+// DomainRecordsOptions specifies the optional parameters to
+// DomainRecordsService.List.
+type DomainRecordsOptions struct {
+	ListOptions
+
+	// Type restricts the records to those of a type, such as "A" or "CNAME".
+	Type string `url:"type,omitempty"`
+
+	// Name restricts the records to those with a fully qualified name, such
+	// as "www.example.com".
+	Name string `url:"name,omitempty"`
+}
+
+// Converts a DomainRecord to a string.
+func (d DomainRecord) String() string {
+	return Stringify(d)
+}
+
+// DomainRecordEditRequest represents a request to create or update a domain
+// record. Priority, Port and Weight are omitted when nil, for record types
+// that don't use them.
+type DomainRecordEditRequest struct {
+	Type     string `json:"type,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Data     string `json:"data,omitempty"`
+	Priority *int   `json:"priority,omitempty"`
+	Port     *int   `json:"port,omitempty"`
+	Weight   *int   `json:"weight,omitempty"`
+}
+
+// RecordEdit is one change applied by DomainRecordsService.BulkEdit. A zero ID
+// creates a record from Request; otherwise the record with ID is edited to
+// match Request, or deleted if Delete is set.
+type RecordEdit struct {
+	ID      int
+	Delete  bool
+	Request *DomainRecordEditRequest
+}
+
+// bulkEditParallelism bounds how many requests BulkEdit has in flight.
+const bulkEditParallelism = 4
+
+// Converts a DomainRecordEditRequest to a string.
+func (d DomainRecordEditRequest) String() string {
+	return Stringify(d)
+}
+
+func domainRecordsPath(domain string) string {
+	return fmt.Sprintf("%s/%s/records", domainsBasePath, domain)
+}
+
+// List returns a slice of DomainRecords for a domain
+func (s *DomainRecordsService) List(ctx context.Context, domain string, opt *DomainRecordsOptions) ([]DomainRecord, *Response, error) {
+	path, err := addOptions(domainRecordsPath(domain), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	records := new(DomainRecordsRoot)
+	resp, err := s.client.Do(req, records)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return records.DomainRecords, resp, err
+}
+
+// Get returns the record id from a domain
+func (s *DomainRecordsService) Get(ctx context.Context, domain string, id int) (*DomainRecord, *Response, error) {
+	path := fmt.Sprintf("%s/%d", domainRecordsPath(domain), id)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	record := new(DomainRecordRoot)
+	resp, err := s.client.Do(req, record)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record.DomainRecord, resp, err
+}
+
+// Create creates a record using a DomainRecordEditRequest
+func (s *DomainRecordsService) Create(
+	ctx context.Context,
+	domain string,
+	createRequest *DomainRecordEditRequest) (*DomainRecord, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", domainRecordsPath(domain), createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(DomainRecordRoot)
+	resp, err := s.client.Do(req, d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d.DomainRecord, resp, err
+}
+
+// Edit edits a record using a DomainRecordEditRequest
+func (s *DomainRecordsService) Edit(
+	ctx context.Context,
+	domain string,
+	id int,
+	editRequest *DomainRecordEditRequest) (*DomainRecord, *Response, error) {
+	path := fmt.Sprintf("%s/%d", domainRecordsPath(domain), id)
+
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", path, editRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(DomainRecordRoot)
+	resp, err := s.client.Do(req, d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d.DomainRecord, resp, err
+}
+
+// Delete deletes a record from a domain identified by id
+func (s *DomainRecordsService) Delete(ctx context.Context, domain string, id int) (*Response, error) {
+	path := fmt.Sprintf("%s/%d", domainRecordsPath(domain), id)
+
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+
+	return resp, err
+}
+
+// Validate checks that edit has the fields its record type requires, without
+// making any request: a priority for MX records, a port and weight for SRV
+// records, and an IPv4 or IPv6 address as the data of A or AAAA records.
+func (s *DomainRecordsService) Validate(edit *DomainRecordEditRequest) error {
+	if edit == nil {
+		return errors.New("domain record edit request is nil")
+	}
+
+	switch recordType := strings.ToUpper(edit.Type); recordType {
+	case "A":
+		if ip := net.ParseIP(edit.Data); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("A record data %q is not an IPv4 address", edit.Data)
+		}
+	case "AAAA":
+		if ip := net.ParseIP(edit.Data); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("AAAA record data %q is not an IPv6 address", edit.Data)
+		}
+	case "MX":
+		if edit.Priority == nil {
+			return errors.New("MX record requires a priority")
+		}
+	case "SRV":
+		if edit.Port == nil {
+			return errors.New("SRV record requires a port")
+		}
+		if edit.Weight == nil {
+			return errors.New("SRV record requires a weight")
+		}
+	}
+
+	return nil
+}
+
+// BulkEdit applies edits to the records of domain, several at a time. The
+// records that result are returned in the order of edits, with nil for deleted
+// records and failed edits. The errors of failed edits are joined into the
+// returned error; the other edits are still applied. Edits that are invalid,
+// such as a delete without an ID or an edit without a Request, fail without a
+// request being made, as do edits not yet started when ctx is done.
+func (s *DomainRecordsService) BulkEdit(ctx context.Context, domain string, edits []RecordEdit) ([]*DomainRecord, error) {
+	records := make([]*DomainRecord, len(edits))
+	errs := make([]error, len(edits))
+
+	sem := make(chan struct{}, bulkEditParallelism)
+	var wg sync.WaitGroup
+	for i, edit := range edits {
+		switch {
+		case edit.Delete && edit.ID == 0:
+			errs[i] = fmt.Errorf("edit %d: cannot delete a record without an ID", i)
+			continue
+		case !edit.Delete && edit.Request == nil:
+			errs[i] = fmt.Errorf("edit %d: domain record edit request is nil", i)
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = fmt.Errorf("edit %d: %w", i, ctx.Err())
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, edit RecordEdit) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var err error
+			switch {
+			case edit.ID == 0:
+				records[i], _, err = s.Create(ctx, domain, edit.Request)
+			case edit.Delete:
+				_, err = s.Delete(ctx, domain, edit.ID)
+			default:
+				records[i], _, err = s.Edit(ctx, domain, edit.ID, edit.Request)
+			}
+			if err != nil {
+				errs[i] = fmt.Errorf("edit %d: %w", i, err)
+			}
+		}(i, edit)
+	}
+	wg.Wait()
+
+	return records, errors.Join(errs...)
+}
@@ -0,0 +1,327 @@
+package godo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDomainRecords_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"domain_records":[
+			{"id":1,"type":"A","name":"www","data":"162.10.66.0","priority":null,"port":null,"weight":null},
+			{"id":2,"type":"SRV","name":"_sip._tcp","data":"sip","priority":10,"port":5060,"weight":5}
+		]}`)
+	})
+
+	records, _, err := client.DomainRecords.List(ctx, "example.com", nil)
+	if err != nil {
+		t.Errorf("DomainRecords.List returned error: %v", err)
+	}
+
+	expected := []DomainRecord{
+		{ID: 1, Type: "A", Name: "www", Data: "162.10.66.0"},
+		{ID: 2, Type: "SRV", Name: "_sip._tcp", Data: "sip", Priority: Int(10), Port: Int(5060), Weight: Int(5)},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("DomainRecords.List returned %+v, expected %+v", records, expected)
+	}
+}
+
+func TestDomainRecords_List_filtered(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"type": "A", "name": "www.example.com", "per_page": "50"})
+		fmt.Fprint(w, `{"domain_records":[{"id":1,"type":"A","name":"www","data":"162.10.66.0"}]}`)
+	})
+
+	opt := &DomainRecordsOptions{Type: "A", Name: "www.example.com", ListOptions: ListOptions{PerPage: 50}}
+	records, _, err := client.DomainRecords.List(ctx, "example.com", opt)
+	if err != nil {
+		t.Errorf("DomainRecords.List returned error: %v", err)
+	}
+
+	expected := []DomainRecord{{ID: 1, Type: "A", Name: "www", Data: "162.10.66.0"}}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("DomainRecords.List returned %+v, expected %+v", records, expected)
+	}
+}
+
+func TestDomainRecords_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains/example.com/records/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"domain_record":{"id":1,"type":"A","name":"www","data":"162.10.66.0"}}`)
+	})
+
+	record, _, err := client.DomainRecords.Get(ctx, "example.com", 1)
+	if err != nil {
+		t.Errorf("DomainRecords.Get returned error: %v", err)
+	}
+
+	expected := &DomainRecord{ID: 1, Type: "A", Name: "www", Data: "162.10.66.0"}
+	if !reflect.DeepEqual(record, expected) {
+		t.Errorf("DomainRecords.Get returned %+v, expected %+v", record, expected)
+	}
+}
+
+func TestDomainRecords_Create(t *testing.T) {
+	tests := []struct {
+		desc          string
+		createRequest *DomainRecordEditRequest
+		body          string
+	}{
+		{
+			"A",
+			&DomainRecordEditRequest{Type: "A", Name: "www", Data: "162.10.66.0"},
+			`{"type":"A","name":"www","data":"162.10.66.0"}`,
+		},
+		{
+			"SRV",
+			&DomainRecordEditRequest{
+				Type:     "SRV",
+				Name:     "_sip._tcp",
+				Data:     "sip",
+				Priority: Int(10),
+				Port:     Int(5060),
+				Weight:   Int(0),
+			},
+			`{"type":"SRV","name":"_sip._tcp","data":"sip","priority":10,"port":5060,"weight":0}`,
+		},
+	}
+
+	for _, tt := range tests {
+		setup()
+
+		mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+
+			testMethod(t, r, "POST")
+			if got := strings.TrimSpace(string(body)); got != tt.body {
+				t.Errorf("%s: Request body = %v, expected %v", tt.desc, got, tt.body)
+			}
+
+			fmt.Fprint(w, `{"domain_record":{"id":1}}`)
+		})
+
+		record, _, err := client.DomainRecords.Create(ctx, "example.com", tt.createRequest)
+		if err != nil {
+			t.Errorf("%s: DomainRecords.Create returned error: %v", tt.desc, err)
+		}
+
+		expected := &DomainRecord{ID: 1}
+		if !reflect.DeepEqual(record, expected) {
+			t.Errorf("%s: DomainRecords.Create returned %+v, expected %+v", tt.desc, record, expected)
+		}
+
+		teardown()
+	}
+}
+
+func TestDomainRecords_Edit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	editRequest := &DomainRecordEditRequest{Type: "A", Name: "www", Data: "162.10.66.1"}
+
+	mux.HandleFunc("/v2/domains/example.com/records/1", func(w http.ResponseWriter, r *http.Request) {
+		v := new(DomainRecordEditRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "PUT")
+		if !reflect.DeepEqual(v, editRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, editRequest)
+		}
+
+		fmt.Fprint(w, `{"domain_record":{"id":1,"type":"A","name":"www","data":"162.10.66.1"}}`)
+	})
+
+	record, _, err := client.DomainRecords.Edit(ctx, "example.com", 1, editRequest)
+	if err != nil {
+		t.Errorf("DomainRecords.Edit returned error: %v", err)
+	}
+
+	expected := &DomainRecord{ID: 1, Type: "A", Name: "www", Data: "162.10.66.1"}
+	if !reflect.DeepEqual(record, expected) {
+		t.Errorf("DomainRecords.Edit returned %+v, expected %+v", record, expected)
+	}
+}
+
+func TestDomainRecords_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains/example.com/records/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.DomainRecords.Delete(ctx, "example.com", 1)
+	if err != nil {
+		t.Errorf("DomainRecords.Delete returned error: %v", err)
+	}
+}
+
+func TestDomainRecords_BulkEdit(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"domain_record":{"id":3,"type":"A","name":"api","data":"10.0.0.3"}}`)
+	})
+
+	mux.HandleFunc("/v2/domains/example.com/records/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		v := new(DomainRecordEditRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		if v.Data != "10.0.0.1" {
+			t.Errorf("Request body = %+v, expected Data 10.0.0.1", v)
+		}
+		fmt.Fprint(w, `{"domain_record":{"id":1,"type":"A","name":"www","data":"10.0.0.1"}}`)
+	})
+
+	deleted := false
+	mux.HandleFunc("/v2/domains/example.com/records/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		deleted = true
+	})
+
+	edits := []RecordEdit{
+		{Request: &DomainRecordEditRequest{Type: "A", Name: "api", Data: "10.0.0.3"}},
+		{ID: 1, Request: &DomainRecordEditRequest{Data: "10.0.0.1"}},
+		{ID: 2, Delete: true},
+	}
+
+	records, err := client.DomainRecords.BulkEdit(ctx, "example.com", edits)
+	if err != nil {
+		t.Errorf("DomainRecords.BulkEdit returned error: %v", err)
+	}
+
+	expected := []*DomainRecord{
+		{ID: 3, Type: "A", Name: "api", Data: "10.0.0.3"},
+		{ID: 1, Type: "A", Name: "www", Data: "10.0.0.1"},
+		nil,
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Errorf("DomainRecords.BulkEdit returned %+v, expected %+v", records, expected)
+	}
+	if !deleted {
+		t.Error("DomainRecords.BulkEdit did not delete record 2")
+	}
+}
+
+func TestDomainRecords_BulkEdit_errors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains/example.com/records/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+	})
+
+	mux.HandleFunc("/v2/domains/example.com/records/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	edits := []RecordEdit{
+		{ID: 1, Delete: true},
+		{ID: 2, Delete: true},
+	}
+
+	_, err := client.DomainRecords.BulkEdit(ctx, "example.com", edits)
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected a 404 ErrorResponse; got %v", err)
+	}
+	if !strings.Contains(err.Error(), "edit 0") || strings.Contains(err.Error(), "edit 1") {
+		t.Errorf("DomainRecords.BulkEdit error = %v, expected only edit 0 to fail", err)
+	}
+}
+
+func TestDomainRecords_BulkEdit_invalid(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requested := false
+	mux.HandleFunc("/v2/domains/example.com/records", func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	})
+
+	edits := []RecordEdit{
+		{ID: 0, Delete: true},
+		{ID: 0},
+	}
+
+	_, err := client.DomainRecords.BulkEdit(ctx, "example.com", edits)
+	if err == nil || !strings.Contains(err.Error(), "edit 0") || !strings.Contains(err.Error(), "edit 1") {
+		t.Errorf("DomainRecords.BulkEdit error = %v, expected edits 0 and 1 to fail", err)
+	}
+	if requested {
+		t.Error("DomainRecords.BulkEdit made a request for an invalid edit")
+	}
+}
+
+func TestDomainRecords_BulkEdit_canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	edits := make([]RecordEdit, bulkEditParallelism+2)
+	for i := range edits {
+		edits[i] = RecordEdit{ID: i + 1, Delete: true}
+	}
+
+	_, err := client.DomainRecords.BulkEdit(ctx, "example.com", edits)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("DomainRecords.BulkEdit error = %v, expected context.Canceled", err)
+	}
+}
+
+func TestDomainRecords_Validate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tests := []struct {
+		name    string
+		edit    *DomainRecordEditRequest
+		wantErr bool
+	}{
+		{"nil", nil, true},
+		{"A", &DomainRecordEditRequest{Type: "A", Data: "192.0.2.1"}, false},
+		{"A missing data", &DomainRecordEditRequest{Type: "A"}, true},
+		{"A with IPv6 data", &DomainRecordEditRequest{Type: "A", Data: "2001:db8::1"}, true},
+		{"AAAA", &DomainRecordEditRequest{Type: "AAAA", Data: "2001:db8::1"}, false},
+		{"AAAA missing data", &DomainRecordEditRequest{Type: "AAAA"}, true},
+		{"AAAA with IPv4 data", &DomainRecordEditRequest{Type: "AAAA", Data: "192.0.2.1"}, true},
+		{"MX", &DomainRecordEditRequest{Type: "MX", Data: "mail.example.com.", Priority: Int(10)}, false},
+		{"MX missing priority", &DomainRecordEditRequest{Type: "MX", Data: "mail.example.com."}, true},
+		{"SRV", &DomainRecordEditRequest{Type: "SRV", Data: "sip.example.com.", Port: Int(5060), Weight: Int(10)}, false},
+		{"SRV missing port", &DomainRecordEditRequest{Type: "SRV", Data: "sip.example.com.", Weight: Int(10)}, true},
+		{"SRV missing weight", &DomainRecordEditRequest{Type: "SRV", Data: "sip.example.com.", Port: Int(5060)}, true},
+		{"CNAME", &DomainRecordEditRequest{Type: "CNAME", Name: "www", Data: "@"}, false},
+	}
+
+	for _, tt := range tests {
+		err := client.DomainRecords.Validate(tt.edit)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: DomainRecords.Validate returned %v, expected error: %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package godo
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 const domainsBasePath = "v2/domains"
 
@@ -10,94 +13,87 @@ type DomainsService struct {
 	client *Client
 }
 
-type DomainRecordRoot struct {
-	DomainRecord *DomainRecord `json:"domain_record"`
-}
-
-type DomainRecordsRoot struct {
-	DomainRecords []DomainRecord `json:"domain_records"`
-}
-
-// DomainRecord represents a DigitalOcean DomainRecord
-type DomainRecord struct {
-	ID       int    `json:"id,float64,omitempty"`
-	Type     string `json:"type,omitempty"`
+// Domain represents a DigitalOcean domain
+type Domain struct {
 	Name     string `json:"name,omitempty"`
-	Data     string `json:"data,omitempty"`
-	Priority int    `json:"priority,omitempty"`
-	Port     int    `json:"port,omitempty"`
-	Weight   int    `json:"weight,omitempty"`
+	TTL      int    `json:"ttl,omitempty"`
+	ZoneFile string `json:"zone_file,omitempty"`
 }
 
-type DomainRecordsOptions struct {
-	ListOptions
+type domainRoot struct {
+	Domain *Domain `json:"domain"`
 }
 
-// Converts a DomainRecord to a string.
-func (d DomainRecord) String() string {
-	return Stringify(d)
+type domainsRoot struct {
+	Domains []Domain `json:"domains"`
 }
 
-// DomainRecordEditRequest represents a request to update a domain record.
-type DomainRecordEditRequest struct {
-	Type     string `json:"type,omitempty"`
-	Name     string `json:"name,omitempty"`
-	Data     string `json:"data,omitempty"`
-	Priority int    `json:"priority,omitempty"`
-	Port     int    `json:"port,omitempty"`
-	Weight   int    `json:"weight,omitempty"`
+// DomainCreateRequest represents a request to create a domain.
+type DomainCreateRequest struct {
+	Name      string `json:"name"`
+	IPAddress string `json:"ip_address"`
 }
 
-// Converts a DomainRecordEditRequest to a string.
-func (d DomainRecordEditRequest) String() string {
+// Converts a Domain to a string.
+func (d Domain) String() string {
 	return Stringify(d)
 }
 
-// Records returns a slice of DomainRecords for a domain
-func (s *DomainsService) Records(domain string, opt *DomainRecordsOptions) ([]DomainRecord, *Response, error) {
-	path := fmt.Sprintf("%s/%s/records", domainsBasePath, domain)
-	path, err := addOptions(path, opt)
+// List all domains
+func (s *DomainsService) List(ctx context.Context) ([]Domain, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", domainsBasePath, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest("GET", path, nil)
+	root := new(domainsRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Domains, resp, err
+}
+
+// Get individual domain
+func (s *DomainsService) Get(ctx context.Context, name string) (*Domain, *Response, error) {
+	path := fmt.Sprintf("%s/%s", domainsBasePath, name)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	records := new(DomainRecordsRoot)
-	resp, err := s.client.Do(req, records)
+	root := new(domainRoot)
+	resp, err := s.client.Do(req, root)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return records.DomainRecords, resp, err
+	return root.Domain, resp, err
 }
 
-// Record returns the record id from a domain
-func (s *DomainsService) Record(domain string, id int) (*DomainRecord, *Response, error) {
-	path := fmt.Sprintf("%s/%s/records/%d", domainsBasePath, domain, id)
-
-	req, err := s.client.NewRequest("GET", path, nil)
+// Create a new domain
+func (s *DomainsService) Create(ctx context.Context, createRequest *DomainCreateRequest) (*Domain, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", domainsBasePath, createRequest)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	record := new(DomainRecordRoot)
-	resp, err := s.client.Do(req, record)
+	root := new(domainRoot)
+	resp, err := s.client.Do(req, root)
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return record.DomainRecord, resp, err
+	return root.Domain, resp, err
 }
 
-// DeleteRecord deletes a record from a domain identified by id
-func (s *DomainsService) DeleteRecord(domain string, id int) (*Response, error) {
-	path := fmt.Sprintf("%s/%s/records/%d", domainsBasePath, domain, id)
+// Delete domain
+func (s *DomainsService) Delete(ctx context.Context, name string) (*Response, error) {
+	path := fmt.Sprintf("%s/%s", domainsBasePath, name)
 
-	req, err := s.client.NewRequest("DELETE", path, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -107,43 +103,44 @@ func (s *DomainsService) DeleteRecord(domain string, id int) (*Response, error)
 	return resp, err
 }
 
+// Records returns a slice of DomainRecords for a domain
+//
+// Deprecated: use DomainRecordsService.List
+func (s *DomainsService) Records(ctx context.Context, domain string, opt *DomainRecordsOptions) ([]DomainRecord, *Response, error) {
+	return s.client.DomainRecords.List(ctx, domain, opt)
+}
+
+// Record returns the record id from a domain
+//
+// Deprecated: use DomainRecordsService.Get
+func (s *DomainsService) Record(ctx context.Context, domain string, id int) (*DomainRecord, *Response, error) {
+	return s.client.DomainRecords.Get(ctx, domain, id)
+}
+
+// DeleteRecord deletes a record from a domain identified by id
+//
+// Deprecated: use DomainRecordsService.Delete
+func (s *DomainsService) DeleteRecord(ctx context.Context, domain string, id int) (*Response, error) {
+	return s.client.DomainRecords.Delete(ctx, domain, id)
+}
+
 // EditRecord edits a record using a DomainRecordEditRequest
+//
+// Deprecated: use DomainRecordsService.Edit
 func (s *DomainsService) EditRecord(
+	ctx context.Context,
 	domain string,
 	id int,
 	editRequest *DomainRecordEditRequest) (*DomainRecord, *Response, error) {
-	path := fmt.Sprintf("%s/%s/records/%d", domainsBasePath, domain, id)
-
-	req, err := s.client.NewRequest("PUT", path, editRequest)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	d := new(DomainRecord)
-	resp, err := s.client.Do(req, d)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	return d, resp, err
+	return s.client.DomainRecords.Edit(ctx, domain, id, editRequest)
 }
 
 // CreateRecord creates a record using a DomainRecordEditRequest
+//
+// Deprecated: use DomainRecordsService.Create
 func (s *DomainsService) CreateRecord(
+	ctx context.Context,
 	domain string,
 	createRequest *DomainRecordEditRequest) (*DomainRecord, *Response, error) {
-	path := fmt.Sprintf("%s/%s/records", domainsBasePath, domain)
-	req, err := s.client.NewRequest("POST", path, createRequest)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	d := new(DomainRecordRoot)
-	resp, err := s.client.Do(req, d)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	return d.DomainRecord, resp, err
+	return s.client.DomainRecords.Create(ctx, domain, createRequest)
 }
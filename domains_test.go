@@ -8,6 +8,92 @@ import (
 	"testing"
 )
 
+func TestDomains_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"domains":[{"name":"foo.com"},{"name":"bar.com"}]}`)
+	})
+
+	domains, _, err := client.Domains.List(ctx)
+	if err != nil {
+		t.Errorf("Domains.List returned error: %v", err)
+	}
+
+	expected := []Domain{{Name: "foo.com"}, {Name: "bar.com"}}
+	if !reflect.DeepEqual(domains, expected) {
+		t.Errorf("Domains.List returned %+v, expected %+v", domains, expected)
+	}
+}
+
+func TestDomains_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains/example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"domain":{"name":"example.com","ttl":1800,"zone_file":"$ORIGIN example.com."}}`)
+	})
+
+	domain, _, err := client.Domains.Get(ctx, "example.com")
+	if err != nil {
+		t.Errorf("Domains.Get returned error: %v", err)
+	}
+
+	expected := &Domain{Name: "example.com", TTL: 1800, ZoneFile: "$ORIGIN example.com."}
+	if !reflect.DeepEqual(domain, expected) {
+		t.Errorf("Domains.Get returned %+v, expected %+v", domain, expected)
+	}
+}
+
+func TestDomains_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &DomainCreateRequest{
+		Name:      "example.com",
+		IPAddress: "127.0.0.1",
+	}
+
+	mux.HandleFunc("/v2/domains", func(w http.ResponseWriter, r *http.Request) {
+		v := new(DomainCreateRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, createRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, createRequest)
+		}
+
+		fmt.Fprint(w, `{"domain":{"name":"example.com"}}`)
+	})
+
+	domain, _, err := client.Domains.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Domains.Create returned error: %v", err)
+	}
+
+	expected := &Domain{Name: "example.com"}
+	if !reflect.DeepEqual(domain, expected) {
+		t.Errorf("Domains.Create returned %+v, expected %+v", domain, expected)
+	}
+}
+
+func TestDomains_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/domains/example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Domains.Delete(ctx, "example.com")
+	if err != nil {
+		t.Errorf("Domains.Delete returned error: %v", err)
+	}
+}
+
 func TestDomains_AllRecordsForDomainName(t *testing.T) {
 	setup()
 	defer teardown()
@@ -17,7 +103,7 @@ func TestDomains_AllRecordsForDomainName(t *testing.T) {
 		fmt.Fprint(w, `{"domain_records":[{"id":1},{"id":2}]}`)
 	})
 
-	records, _, err := client.Domains.Records("example.com", nil)
+	records, _, err := client.Domains.Records(ctx, "example.com", nil)
 	if err != nil {
 		t.Errorf("Domains.List returned error: %v", err)
 	}
@@ -41,8 +127,8 @@ func TestDomains_AllRecordsForDomainName_PerPage(t *testing.T) {
 		fmt.Fprint(w, `{"domain_records":[{"id":1},{"id":2}]}`)
 	})
 
-	dro := &DomainRecordsOptions{ListOptions{PerPage: 2}}
-	records, _, err := client.Domains.Records("example.com", dro)
+	dro := &DomainRecordsOptions{ListOptions: ListOptions{PerPage: 2}}
+	records, _, err := client.Domains.Records(ctx, "example.com", dro)
 	if err != nil {
 		t.Errorf("Domains.List returned error: %v", err)
 	}
@@ -62,7 +148,7 @@ func TestDomains_GetRecordforDomainName(t *testing.T) {
 		fmt.Fprint(w, `{"domain_record":{"id":1}}`)
 	})
 
-	record, _, err := client.Domains.Record("example.com", 1)
+	record, _, err := client.Domains.Record(ctx, "example.com", 1)
 	if err != nil {
 		t.Errorf("Domains.GetRecord returned error: %v", err)
 	}
@@ -81,7 +167,7 @@ func TestDomains_DeleteRecordForDomainName(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Domains.DeleteRecord("example.com", 1)
+	_, err := client.Domains.DeleteRecord(ctx, "example.com", 1)
 	if err != nil {
 		t.Errorf("Domains.RecordDelete returned error: %v", err)
 	}
@@ -95,9 +181,9 @@ func TestDomains_CreateRecordForDomainName(t *testing.T) {
 		Type:     "CNAME",
 		Name:     "example",
 		Data:     "@",
-		Priority: 10,
-		Port:     10,
-		Weight:   10,
+		Priority: Int(10),
+		Port:     Int(10),
+		Weight:   Int(10),
 	}
 
 	mux.HandleFunc("/v2/domains/example.com/records",
@@ -113,7 +199,7 @@ func TestDomains_CreateRecordForDomainName(t *testing.T) {
 			fmt.Fprintf(w, `{"domain_record": {"id":1}}`)
 		})
 
-	record, _, err := client.Domains.CreateRecord("example.com", createRequest)
+	record, _, err := client.Domains.CreateRecord(ctx, "example.com", createRequest)
 	if err != nil {
 		t.Errorf("Domains.CreateRecord returned error: %v", err)
 	}
@@ -132,9 +218,9 @@ func TestDomains_EditRecordForDomainName(t *testing.T) {
 		Type:     "CNAME",
 		Name:     "example",
 		Data:     "@",
-		Priority: 10,
-		Port:     10,
-		Weight:   10,
+		Priority: Int(10),
+		Port:     Int(10),
+		Weight:   Int(10),
 	}
 
 	mux.HandleFunc("/v2/domains/example.com/records/1", func(w http.ResponseWriter, r *http.Request) {
@@ -146,10 +232,10 @@ func TestDomains_EditRecordForDomainName(t *testing.T) {
 			t.Errorf("Request body = %+v, expected %+v", v, editRequest)
 		}
 
-		fmt.Fprintf(w, `{"id":1}`)
+		fmt.Fprintf(w, `{"domain_record": {"id":1}}`)
 	})
 
-	record, _, err := client.Domains.EditRecord("example.com", 1, editRequest)
+	record, _, err := client.Domains.EditRecord(ctx, "example.com", 1, editRequest)
 	if err != nil {
 		t.Errorf("Domains.EditRecord returned error: %v", err)
 	}
@@ -166,9 +252,9 @@ func TestDomainRecord_String(t *testing.T) {
 		Type:     "CNAME",
 		Name:     "example",
 		Data:     "@",
-		Priority: 10,
-		Port:     10,
-		Weight:   10,
+		Priority: Int(10),
+		Port:     Int(10),
+		Weight:   Int(10),
 	}
 
 	stringified := record.String()
@@ -183,9 +269,9 @@ func TestDomainRecordEditRequest_String(t *testing.T) {
 		Type:     "CNAME",
 		Name:     "example",
 		Data:     "@",
-		Priority: 10,
-		Port:     10,
-		Weight:   10,
+		Priority: Int(10),
+		Port:     Int(10),
+		Weight:   Int(10),
 	}
 
 	stringified := record.String()
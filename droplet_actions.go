@@ -1,8 +1,11 @@
 package godo
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strings"
 )
 
 // DropletActionsService handles communication with the droplet action related
@@ -11,32 +14,53 @@ type DropletActionsService struct {
 	client *Client
 }
 
-// Shutdown a Droplet
-func (s *DropletActionsService) Shutdown(id int) (*Action, *Response, error) {
+// DropletLockedError is returned when an action is attempted on a Droplet
+// that is locked by another action in progress.
+type DropletLockedError struct {
+	*ErrorResponse
+
+	// ID of the locked Droplet
+	DropletID int
+}
+
+func (e *DropletLockedError) Error() string {
+	return fmt.Sprintf("droplet %d is locked: %v", e.DropletID, e.ErrorResponse.Error())
+}
+
+// Shutdown a Droplet gracefully. Unlike PowerOff, the guest OS is asked to
+// shut down, giving it a chance to stop services and flush disks.
+func (s *DropletActionsService) Shutdown(ctx context.Context, id int) (*Action, *Response, error) {
 	request := &ActionRequest{Type: "shutdown"}
-	return s.doAction(id, request)
+	return s.doAction(ctx, id, request)
 }
 
-// PowerOff a Droplet
-func (s *DropletActionsService) PowerOff(id int) (*Action, *Response, error) {
+// PowerOff a Droplet. This is a hard stop, equivalent to pulling the power
+// cord; use Shutdown to let the guest OS shut down cleanly.
+func (s *DropletActionsService) PowerOff(ctx context.Context, id int) (*Action, *Response, error) {
 	request := &ActionRequest{Type: "power_off"}
-	return s.doAction(id, request)
+	return s.doAction(ctx, id, request)
+}
+
+// PowerOn a Droplet
+func (s *DropletActionsService) PowerOn(ctx context.Context, id int) (*Action, *Response, error) {
+	request := &ActionRequest{Type: "power_on"}
+	return s.doAction(ctx, id, request)
 }
 
 // PowerCycle a Droplet
-func (s *DropletActionsService) PowerCycle(id int) (*Action, *Response, error) {
+func (s *DropletActionsService) PowerCycle(ctx context.Context, id int) (*Action, *Response, error) {
 	request := &ActionRequest{Type: "power_cycle"}
-	return s.doAction(id, request)
+	return s.doAction(ctx, id, request)
 }
 
 // Reboot a Droplet
-func (s *DropletActionsService) Reboot(id int) (*Action, *Response, error) {
+func (s *DropletActionsService) Reboot(ctx context.Context, id int) (*Action, *Response, error) {
 	request := &ActionRequest{Type: "reboot"}
-	return s.doAction(id, request)
+	return s.doAction(ctx, id, request)
 }
 
 // Restore an image to a Droplet
-func (s *DropletActionsService) Restore(id, imageID int) (*Action, *Response, error) {
+func (s *DropletActionsService) Restore(ctx context.Context, id, imageID int) (*Action, *Response, error) {
 	options := map[string]interface{}{
 		"image": float64(imageID),
 	}
@@ -46,13 +70,33 @@ func (s *DropletActionsService) Restore(id, imageID int) (*Action, *Response, er
 		Type:   requestType,
 		Params: options,
 	}
-	return s.doAction(id, request)
+	return s.doAction(ctx, id, request)
 }
 
-// Resize a Droplet
-func (s *DropletActionsService) Resize(id int, sizeSlug string) (*Action, *Response, error) {
+// Rebuild a Droplet from an image, such as a snapshot or backup of it taken
+// before its filesystem broke. The Droplet keeps its ID and IP addresses, but
+// its disk is replaced by the image. The API has no action to boot a Droplet
+// into rescue mode; rebuilding is how a Droplet is recovered through it.
+func (s *DropletActionsService) Rebuild(ctx context.Context, id int, image ImageRef) (*Action, *Response, error) {
+	options := map[string]interface{}{
+		"image": image,
+	}
+
+	requestType := "rebuild"
+	request := &ActionRequest{
+		Type:   requestType,
+		Params: options,
+	}
+	return s.doAction(ctx, id, request)
+}
+
+// Resize a Droplet. If resizeDisk is true the disk is grown along with CPU and
+// RAM, which makes the resize permanent; otherwise only CPU and RAM change and
+// the Droplet can later be resized back down.
+func (s *DropletActionsService) Resize(ctx context.Context, id int, sizeSlug string, resizeDisk bool) (*Action, *Response, error) {
 	options := map[string]interface{}{
 		"size": sizeSlug,
+		"disk": resizeDisk,
 	}
 
 	requestType := "resize"
@@ -60,11 +104,11 @@ func (s *DropletActionsService) Resize(id int, sizeSlug string) (*Action, *Respo
 		Type:   requestType,
 		Params: options,
 	}
-	return s.doAction(id, request)
+	return s.doAction(ctx, id, request)
 }
 
 // Rename a Droplet
-func (s *DropletActionsService) Rename(id int, name string) (*Action, *Response, error) {
+func (s *DropletActionsService) Rename(ctx context.Context, id int, name string) (*Action, *Response, error) {
 	options := map[string]interface{}{
 		"name": name,
 	}
@@ -74,13 +118,44 @@ func (s *DropletActionsService) Rename(id int, name string) (*Action, *Response,
 		Type:   requestType,
 		Params: options,
 	}
-	return s.doAction(id, request)
+	return s.doAction(ctx, id, request)
 }
 
-func (s *DropletActionsService) doAction(id int, request *ActionRequest) (*Action, *Response, error) {
+// EnableIPv6 enables IPv6 for a Droplet
+func (s *DropletActionsService) EnableIPv6(ctx context.Context, id int) (*Action, *Response, error) {
+	request := &ActionRequest{Type: "enable_ipv6"}
+	return s.doAction(ctx, id, request)
+}
+
+// EnablePrivateNetworking enables private networking for a Droplet
+func (s *DropletActionsService) EnablePrivateNetworking(ctx context.Context, id int) (*Action, *Response, error) {
+	request := &ActionRequest{Type: "enable_private_networking"}
+	return s.doAction(ctx, id, request)
+}
+
+// PasswordReset resets the root password of a Droplet. The new password is
+// not returned by the API; DigitalOcean emails it to the account owner.
+func (s *DropletActionsService) PasswordReset(ctx context.Context, id int) (*Action, *Response, error) {
+	request := &ActionRequest{Type: "password_reset"}
+	return s.doAction(ctx, id, request)
+}
+
+// Snapshot a Droplet. If name is empty DigitalOcean assigns a default name
+// to the snapshot.
+func (s *DropletActionsService) Snapshot(ctx context.Context, id int, name string) (*Action, *Response, error) {
+	request := &ActionRequest{Type: "snapshot"}
+	if name != "" {
+		request.Params = map[string]interface{}{
+			"name": name,
+		}
+	}
+	return s.doAction(ctx, id, request)
+}
+
+func (s *DropletActionsService) doAction(ctx context.Context, id int, request *ActionRequest) (*Action, *Response, error) {
 	path := dropletActionPath(id)
 
-	req, err := s.client.NewRequest("POST", path, request)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, request)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -88,31 +163,61 @@ func (s *DropletActionsService) doAction(id int, request *ActionRequest) (*Actio
 	root := new(actionRoot)
 	resp, err := s.client.Do(req, root)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, s.checkLocked(ctx, id, err)
 	}
 
 	return &root.Event, resp, err
 }
 
+// checkLocked converts an unprocessable entity error returned by an action
+// request into a DropletLockedError if the Droplet turns out to be locked.
+// Any other error is returned unchanged.
+func (s *DropletActionsService) checkLocked(ctx context.Context, id int, err error) error {
+	errResp, ok := err.(*ErrorResponse)
+	if !ok || errResp.Response.StatusCode != http.StatusUnprocessableEntity {
+		return err
+	}
+
+	droplet, _, getErr := s.client.Droplet.Get(ctx, id)
+	if getErr != nil || droplet == nil || !droplet.Locked {
+		return err
+	}
+
+	return &DropletLockedError{ErrorResponse: errResp, DropletID: id}
+}
+
 // Get an action for a particular droplet by id.
-func (s *DropletActionsService) Get(dropletID, actionID int) (*Action, *Response, error) {
+func (s *DropletActionsService) Get(ctx context.Context, dropletID, actionID int) (*Action, *Response, error) {
 	path := fmt.Sprintf("%s/%d", dropletActionPath(dropletID), actionID)
-	return s.get(path)
+	return s.get(ctx, path)
 }
 
-// GetByURI gets an action for a particular droplet by id.
-func (s *DropletActionsService) GetByURI(rawurl string) (*Action, *Response, error) {
+// GetByURI gets an action by its URI, such as the href of a Link. The URI
+// may be absolute; only its path and query are used, and they are resolved
+// against the client's BaseURL. A path that already starts with the BaseURL
+// path is not prefixed with it again.
+func (s *DropletActionsService) GetByURI(ctx context.Context, rawurl string) (*Action, *Response, error) {
 	u, err := url.Parse(rawurl)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	return s.get(u.Path)
+	// drop the BaseURL path only if it is a whole leading segment, so that a
+	// base of /api doesn't eat into /apiv2
+	path := u.Path
+	if base := strings.TrimSuffix(s.client.BaseURL.Path, "/"); base != "" {
+		if path == base || strings.HasPrefix(path, base+"/") {
+			path = path[len(base):]
+		}
+	}
+	path = strings.TrimPrefix(path, "/")
+	rel := &url.URL{Path: path, RawQuery: u.RawQuery}
 
+	return s.get(ctx, rel.String())
 }
 
-func (s *DropletActionsService) get(path string) (*Action, *Response, error) {
-	req, err := s.client.NewRequest("GET", path, nil)
+func (s *DropletActionsService) get(ctx context.Context, path string) (*Action, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -3,8 +3,11 @@ package godo
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -25,15 +28,15 @@ func TestDropletActions_Shutdown(t *testing.T) {
 			t.Errorf("Request body = %+v, expected %+v", v, request)
 		}
 
-		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
+		fmt.Fprintf(w, `{"action":{"status":"in-progress","type":"shutdown"}}`)
 	})
 
-	action, _, err := client.DropletActions.Shutdown(1)
+	action, _, err := client.DropletActions.Shutdown(ctx, 1)
 	if err != nil {
 		t.Errorf("DropletActions.Shutdown returned error: %v", err)
 	}
 
-	expected := &Action{Status: "in-progress"}
+	expected := &Action{Status: "in-progress", Type: "shutdown"}
 	if !reflect.DeepEqual(action, expected) {
 		t.Errorf("DropletActions.Shutdown returned %+v, expected %+v", action, expected)
 	}
@@ -59,7 +62,7 @@ func TestDropletAction_PowerOff(t *testing.T) {
 		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
 	})
 
-	action, _, err := client.DropletActions.PowerOff(1)
+	action, _, err := client.DropletActions.PowerOff(ctx, 1)
 	if err != nil {
 		t.Errorf("DropletActions.Shutdown returned error: %v", err)
 	}
@@ -70,6 +73,46 @@ func TestDropletAction_PowerOff(t *testing.T) {
 	}
 }
 
+func TestDropletAction_Power(t *testing.T) {
+	tests := []struct {
+		actionType string
+		fn         func(int) (*Action, *Response, error)
+	}{
+		{"power_off", func(id int) (*Action, *Response, error) { return client.DropletActions.PowerOff(ctx, id) }},
+		{"power_on", func(id int) (*Action, *Response, error) { return client.DropletActions.PowerOn(ctx, id) }},
+	}
+
+	for _, tt := range tests {
+		setup()
+
+		request := &ActionRequest{Type: tt.actionType}
+
+		mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+			v := new(ActionRequest)
+			json.NewDecoder(r.Body).Decode(v)
+
+			testMethod(t, r, "POST")
+			if !reflect.DeepEqual(v, request) {
+				t.Errorf("Request body = %+v, expected %+v", v, request)
+			}
+
+			fmt.Fprintf(w, `{"action":{"status":"in-progress","type":"%s"}}`, v.Type)
+		})
+
+		action, _, err := tt.fn(1)
+		if err != nil {
+			t.Errorf("DropletActions %s returned error: %v", tt.actionType, err)
+		}
+
+		expected := &Action{Status: "in-progress", Type: tt.actionType}
+		if !reflect.DeepEqual(action, expected) {
+			t.Errorf("DropletActions %s returned %+v, expected %+v", tt.actionType, action, expected)
+		}
+
+		teardown()
+	}
+}
+
 func TestDropletAction_Reboot(t *testing.T) {
 	setup()
 	defer teardown()
@@ -87,18 +130,36 @@ func TestDropletAction_Reboot(t *testing.T) {
 			t.Errorf("Request body = %+v, expected %+v", v, request)
 		}
 
-		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
+		fmt.Fprintf(w, `{"action":{"id":1,"status":"in-progress","type":"reboot"}}`)
 
 	})
 
-	action, _, err := client.DropletActions.Reboot(1)
+	action, _, err := client.DropletActions.Reboot(ctx, 1)
 	if err != nil {
-		t.Errorf("DropletActions.Shutdown returned error: %v", err)
+		t.Errorf("DropletActions.Reboot returned error: %v", err)
 	}
 
-	expected := &Action{Status: "in-progress"}
+	expected := &Action{ID: 1, Status: "in-progress", Type: "reboot"}
 	if !reflect.DeepEqual(action, expected) {
-		t.Errorf("DropletActions.Shutdown returned %+v, expected %+v", action, expected)
+		t.Errorf("DropletActions.Reboot returned %+v, expected %+v", action, expected)
+	}
+}
+
+func TestDropletAction_Reboot_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+	})
+
+	action, _, err := client.DropletActions.Reboot(ctx, 1)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("DropletActions.Reboot returned error %#v, expected an *ErrorResponse", err)
+	}
+
+	if action != nil {
+		t.Errorf("DropletActions.Reboot returned %+v, expected nil", action)
 	}
 }
 
@@ -129,7 +190,7 @@ func TestDropletAction_Restore(t *testing.T) {
 
 	})
 
-	action, _, err := client.DropletActions.Restore(1, 1)
+	action, _, err := client.DropletActions.Restore(ctx, 1, 1)
 	if err != nil {
 		t.Errorf("DropletActions.Shutdown returned error: %v", err)
 	}
@@ -140,16 +201,99 @@ func TestDropletAction_Restore(t *testing.T) {
 	}
 }
 
+func TestDropletAction_Rebuild(t *testing.T) {
+	tests := []struct {
+		image ImageRef
+		param interface{}
+	}{
+		{ImageRefFromID(1234), float64(1234)},
+		{ImageRefFromSlug("ubuntu-14-04-x64"), "ubuntu-14-04-x64"},
+	}
+
+	for _, tt := range tests {
+		setup()
+
+		request := &ActionRequest{
+			Type: "rebuild",
+			Params: map[string]interface{}{
+				"image": tt.param,
+			},
+		}
+
+		mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+			v := new(ActionRequest)
+			json.NewDecoder(r.Body).Decode(v)
+
+			testMethod(t, r, "POST")
+
+			if !reflect.DeepEqual(v, request) {
+				t.Errorf("Request body = %+v, expected %+v", v, request)
+			}
+
+			fmt.Fprintf(w, `{"action":{"status":"in-progress","type":"rebuild"}}`)
+		})
+
+		action, _, err := client.DropletActions.Rebuild(ctx, 1, tt.image)
+		if err != nil {
+			t.Errorf("DropletActions.Rebuild returned error: %v", err)
+		}
+
+		expected := &Action{Status: "in-progress", Type: "rebuild"}
+		if !reflect.DeepEqual(action, expected) {
+			t.Errorf("DropletActions.Rebuild returned %+v, expected %+v", action, expected)
+		}
+
+		teardown()
+	}
+}
+
 func TestDropletAction_Resize(t *testing.T) {
+	tests := []struct {
+		resizeDisk bool
+		expected   string
+	}{
+		{true, `{"type":"resize","disk":true,"size":"1024mb"}`},
+		{false, `{"type":"resize","disk":false,"size":"1024mb"}`},
+	}
+
+	for _, tt := range tests {
+		setup()
+
+		mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+
+			testMethod(t, r, "POST")
+			if got := strings.TrimSpace(string(body)); got != tt.expected {
+				t.Errorf("Request body = %v, expected %v", got, tt.expected)
+			}
+
+			fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
+		})
+
+		action, _, err := client.DropletActions.Resize(ctx, 1, "1024mb", tt.resizeDisk)
+		if err != nil {
+			t.Errorf("DropletActions.Resize returned error: %v", err)
+		}
+
+		expected := &Action{Status: "in-progress"}
+		if !reflect.DeepEqual(action, expected) {
+			t.Errorf("DropletActions.Resize returned %+v, expected %+v", action, expected)
+		}
+
+		teardown()
+	}
+}
+
+func TestDropletAction_Rename(t *testing.T) {
 	setup()
 	defer teardown()
 
 	options := map[string]interface{}{
-		"size": "1024mb",
+		"name": "Droplet-Name",
 	}
 
 	request := &ActionRequest{
-		Type:   "resize",
+		Type:   "rename",
 		Params: options,
 	}
 
@@ -164,10 +308,9 @@ func TestDropletAction_Resize(t *testing.T) {
 		}
 
 		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
-
 	})
 
-	action, _, err := client.DropletActions.Resize(1, "1024mb")
+	action, _, err := client.DropletActions.Rename(ctx, 1, "Droplet-Name")
 	if err != nil {
 		t.Errorf("DropletActions.Shutdown returned error: %v", err)
 	}
@@ -178,17 +321,12 @@ func TestDropletAction_Resize(t *testing.T) {
 	}
 }
 
-func TestDropletAction_Rename(t *testing.T) {
+func TestDropletAction_PowerCycle(t *testing.T) {
 	setup()
 	defer teardown()
 
-	options := map[string]interface{}{
-		"name": "Droplet-Name",
-	}
-
 	request := &ActionRequest{
-		Type:   "rename",
-		Params: options,
+		Type: "power_cycle",
 	}
 
 	mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
@@ -196,15 +334,15 @@ func TestDropletAction_Rename(t *testing.T) {
 		json.NewDecoder(r.Body).Decode(v)
 
 		testMethod(t, r, "POST")
-
 		if !reflect.DeepEqual(v, request) {
 			t.Errorf("Request body = %+v, expected %+v", v, request)
 		}
 
 		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
+
 	})
 
-	action, _, err := client.DropletActions.Rename(1, "Droplet-Name")
+	action, _, err := client.DropletActions.PowerCycle(ctx, 1)
 	if err != nil {
 		t.Errorf("DropletActions.Shutdown returned error: %v", err)
 	}
@@ -215,14 +353,15 @@ func TestDropletAction_Rename(t *testing.T) {
 	}
 }
 
-func TestDropletAction_PowerCycle(t *testing.T) {
+func TestDropletAction_EnableIPv6(t *testing.T) {
 	setup()
 	defer teardown()
 
 	request := &ActionRequest{
-		Type: "power_cycle",
+		Type: "enable_ipv6",
 	}
 
+	enabled := false
 	mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
 		v := new(ActionRequest)
 		json.NewDecoder(r.Body).Decode(v)
@@ -232,18 +371,168 @@ func TestDropletAction_PowerCycle(t *testing.T) {
 			t.Errorf("Request body = %+v, expected %+v", v, request)
 		}
 
-		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
+		enabled = true
+		fmt.Fprintf(w, `{"action":{"status":"completed","type":"enable_ipv6"}}`)
+	})
+
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if !enabled {
+			fmt.Fprint(w, `{"droplet":{"id":1,"networks":{"v4":[{"ip_address":"10.0.0.2","type":"public"}]}}}`)
+			return
+		}
 
+		fmt.Fprint(w, `{"droplet":{"id":1,"networks":{`+
+			`"v4":[{"ip_address":"10.0.0.2","type":"public"}],`+
+			`"v6":[{"ip_address":"2604:A880:0800:0010:0000:0000:02DD:4001","type":"public"}]}}}`)
 	})
 
-	action, _, err := client.DropletActions.PowerCycle(1)
+	action, _, err := client.DropletActions.EnableIPv6(ctx, 1)
 	if err != nil {
-		t.Errorf("DropletActions.Shutdown returned error: %v", err)
+		t.Errorf("DropletActions.EnableIPv6 returned error: %v", err)
 	}
 
-	expected := &Action{Status: "in-progress"}
+	expected := &Action{Status: "completed", Type: "enable_ipv6"}
 	if !reflect.DeepEqual(action, expected) {
-		t.Errorf("DropletActions.Shutdown returned %+v, expected %+v", action, expected)
+		t.Errorf("DropletActions.EnableIPv6 returned %+v, expected %+v", action, expected)
+	}
+
+	droplet, _, err := client.Droplet.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("Droplets.Get returned error: %v", err)
+	}
+
+	expectedV6 := []Network{{IPAddress: "2604:A880:0800:0010:0000:0000:02DD:4001", Type: "public"}}
+	if !reflect.DeepEqual(droplet.Networks.V6, expectedV6) {
+		t.Errorf("Droplet.Networks.V6 = %+v, expected %+v", droplet.Networks.V6, expectedV6)
+	}
+}
+
+func TestDropletAction_EnablePrivateNetworking(t *testing.T) {
+	setup()
+	defer teardown()
+
+	request := &ActionRequest{
+		Type: "enable_private_networking",
+	}
+
+	enabled := false
+	mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+		v := new(ActionRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, request) {
+			t.Errorf("Request body = %+v, expected %+v", v, request)
+		}
+
+		enabled = true
+		fmt.Fprintf(w, `{"action":{"status":"completed","type":"enable_private_networking"}}`)
+	})
+
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		networks := `{"ip_address":"104.131.186.241","type":"public"}`
+		if enabled {
+			networks += `,{"ip_address":"10.128.1.2","type":"private"}`
+		}
+
+		fmt.Fprintf(w, `{"droplet":{"id":1,"networks":{"v4":[%s]}}}`, networks)
+	})
+
+	action, _, err := client.DropletActions.EnablePrivateNetworking(ctx, 1)
+	if err != nil {
+		t.Errorf("DropletActions.EnablePrivateNetworking returned error: %v", err)
+	}
+
+	expected := &Action{Status: "completed", Type: "enable_private_networking"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("DropletActions.EnablePrivateNetworking returned %+v, expected %+v", action, expected)
+	}
+
+	droplet, _, err := client.Droplet.Get(ctx, 1)
+	if err != nil {
+		t.Fatalf("Droplets.Get returned error: %v", err)
+	}
+
+	var private *Network
+	for i, network := range droplet.Networks.V4 {
+		if network.Type == "private" {
+			private = &droplet.Networks.V4[i]
+		}
+	}
+
+	expectedPrivate := &Network{IPAddress: "10.128.1.2", Type: "private"}
+	if !reflect.DeepEqual(private, expectedPrivate) {
+		t.Errorf("Droplet private network = %+v, expected %+v", private, expectedPrivate)
+	}
+}
+
+func TestDropletAction_PasswordReset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	request := &ActionRequest{
+		Type: "password_reset",
+	}
+
+	mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+		v := new(ActionRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, request) {
+			t.Errorf("Request body = %+v, expected %+v", v, request)
+		}
+
+		fmt.Fprintf(w, `{"action":{"status":"in-progress","type":"password_reset"}}`)
+	})
+
+	action, _, err := client.DropletActions.PasswordReset(ctx, 1)
+	if err != nil {
+		t.Errorf("DropletActions.PasswordReset returned error: %v", err)
+	}
+
+	expected := &Action{Status: "in-progress", Type: "password_reset"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("DropletActions.PasswordReset returned %+v, expected %+v", action, expected)
+	}
+}
+
+func TestDropletAction_Snapshot(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"before-upgrade", `{"type":"snapshot","name":"before-upgrade"}`},
+		{"", `{"type":"snapshot"}`},
+	}
+
+	for _, tt := range tests {
+		setup()
+
+		mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+
+			testMethod(t, r, "POST")
+			if got := strings.TrimSpace(string(body)); got != tt.expected {
+				t.Errorf("Request body = %v, expected %v", got, tt.expected)
+			}
+
+			fmt.Fprintf(w, `{"action":{"status":"in-progress","type":"snapshot"}}`)
+		})
+
+		action, _, err := client.DropletActions.Snapshot(ctx, 1, tt.name)
+		if err != nil {
+			t.Errorf("DropletActions.Snapshot returned error: %v", err)
+		}
+
+		expected := &Action{Status: "in-progress", Type: "snapshot"}
+		if !reflect.DeepEqual(action, expected) {
+			t.Errorf("DropletActions.Snapshot returned %+v, expected %+v", action, expected)
+		}
+
+		teardown()
 	}
 }
 
@@ -256,7 +545,7 @@ func TestDropletActions_Get(t *testing.T) {
 		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
 	})
 
-	action, _, err := client.DropletActions.Get(123, 456)
+	action, _, err := client.DropletActions.Get(ctx, 123, 456)
 	if err != nil {
 		t.Errorf("DropletActions.Get returned error: %v", err)
 	}
@@ -266,3 +555,122 @@ func TestDropletActions_Get(t *testing.T) {
 		t.Errorf("DropletActions.Get returned %+v, expected %+v", action, expected)
 	}
 }
+
+func TestDropletActions_Locked(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Droplet already has a pending event."}`)
+	})
+
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"droplet":{"id":1,"locked":true}}`)
+	})
+
+	_, _, err := client.DropletActions.Reboot(ctx, 1)
+	lockedErr, ok := err.(*DropletLockedError)
+	if !ok {
+		t.Fatalf("DropletActions.Reboot returned %#v, expected a *DropletLockedError", err)
+	}
+
+	if lockedErr.DropletID != 1 {
+		t.Errorf("DropletLockedError.DropletID = %v, expected %v", lockedErr.DropletID, 1)
+	}
+}
+
+func TestDropletActions_UnprocessableNotLocked(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"invalid size"}`)
+	})
+
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"droplet":{"id":1,"locked":false}}`)
+	})
+
+	_, _, err := client.DropletActions.Resize(ctx, 1, "bogus", false)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("DropletActions.Resize returned %#v, expected an *ErrorResponse", err)
+	}
+}
+
+func TestDropletActions_GetByURI(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/actions/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"action":{"id":123,"status":"in-progress"}}`)
+	})
+
+	action, _, err := client.DropletActions.GetByURI(ctx, server.URL+"/v2/actions/123")
+	if err != nil {
+		t.Errorf("DropletActions.GetByURI returned error: %v", err)
+	}
+
+	expected := &Action{ID: 123, Status: "in-progress"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("DropletActions.GetByURI returned %+v, expected %+v", action, expected)
+	}
+}
+
+func TestDropletActions_GetByURI_baseURLPath(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.BaseURL, _ = url.Parse(server.URL + "/proxy/")
+
+	mux.HandleFunc("/proxy/v2/actions/123", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"action":{"id":123,"status":"completed"}}`)
+	})
+
+	action, _, err := client.DropletActions.GetByURI(ctx, "https://api.digitalocean.com/v2/actions/123")
+	if err != nil {
+		t.Errorf("DropletActions.GetByURI returned error: %v", err)
+	}
+
+	expected := &Action{ID: 123, Status: "completed"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("DropletActions.GetByURI returned %+v, expected %+v", action, expected)
+	}
+}
+
+func TestDropletActions_GetByURI_baseURLPathSegments(t *testing.T) {
+	tests := []struct {
+		uri, path string
+	}{
+		{"https://api.digitalocean.com/api/v2/actions/123", "/api/v2/actions/123"},
+		{"https://api.digitalocean.com/apiv2/actions/123", "/api/apiv2/actions/123"},
+	}
+
+	for _, tt := range tests {
+		setup()
+
+		client.BaseURL, _ = url.Parse(server.URL + "/api")
+
+		mux.HandleFunc(tt.path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			fmt.Fprintf(w, `{"action":{"id":123,"status":"completed"}}`)
+		})
+
+		action, _, err := client.DropletActions.GetByURI(ctx, tt.uri)
+		if err != nil {
+			t.Errorf("DropletActions.GetByURI(%q) returned error: %v", tt.uri, err)
+		}
+
+		expected := &Action{ID: 123, Status: "completed"}
+		if !reflect.DeepEqual(action, expected) {
+			t.Errorf("DropletActions.GetByURI(%q) returned %+v, expected %+v", tt.uri, action, expected)
+		}
+
+		teardown()
+	}
+}
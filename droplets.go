@@ -1,6 +1,16 @@
 package godo
 
-import "fmt"
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+)
 
 const dropletBasePath = "v2/droplets"
 
@@ -10,22 +20,42 @@ type DropletsService struct {
 	client *Client
 }
 
-// Droplet represents a DigitalOcean Droplet
+// Droplet represents a DigitalOcean Droplet. As in the API, Memory is in
+// megabytes and Disk in gigabytes; MemoryMB, MemoryGB and DiskGB spell out the
+// units.
 type Droplet struct {
-	ID          int       `json:"id,float64,omitempty"`
-	Name        string    `json:"name,omitempty"`
-	Memory      int       `json:"memory,omitempty"`
-	Vcpus       int       `json:"vcpus,omitempty"`
-	Disk        int       `json:"disk,omitempty"`
-	Region      *Region   `json:"region,omitempty"`
-	Image       *Image    `json:"image,omitempty"`
-	Size        *Size     `json:"size,omitempty"`
-	BackupIDs   []int     `json:"backup_ids,omitempty"`
-	SnapshotIDs []int     `json:"snapshot_ids,omitempty"`
-	Locked      bool      `json:"locked,bool,omitempty"`
-	Status      string    `json:"status,omitempty"`
-	Networks    *Networks `json:"networks,omitempty"`
-	ActionIDs   []int     `json:"action_ids,omitempty"`
+	ID          int        `json:"id,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Memory      int        `json:"memory,omitempty"`
+	Vcpus       int        `json:"vcpus,omitempty"`
+	Disk        int        `json:"disk,omitempty"`
+	Region      *Region    `json:"region,omitempty"`
+	Image       *Image     `json:"image,omitempty"`
+	Size        *Size      `json:"size,omitempty"`
+	SizeSlug    string     `json:"size_slug,omitempty"`
+	BackupIDs   []int      `json:"backup_ids,omitempty"`
+	SnapshotIDs []int      `json:"snapshot_ids,omitempty"`
+	Locked      bool       `json:"locked,bool,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	Networks    *Networks  `json:"networks,omitempty"`
+	ActionIDs   []int      `json:"action_ids,omitempty"`
+	Features    []string   `json:"features,omitempty"`
+	DiskInfo    []DiskInfo `json:"disk_info,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
+}
+
+// DiskInfo describes one of a Droplet's disks, such as its local disk or a
+// scratch disk.
+type DiskInfo struct {
+	Type string   `json:"type,omitempty"`
+	Size DiskSize `json:"size,omitempty"`
+}
+
+// DiskSize is the size of a disk in the given unit, such as "gib".
+type DiskSize struct {
+	Amount int    `json:"amount,omitempty"`
+	Unit   string `json:"unit,omitempty"`
 }
 
 // Convert Droplet to a string
@@ -33,8 +63,92 @@ func (d Droplet) String() string {
 	return Stringify(d)
 }
 
-// DropletRoot represents a Droplet root
-type DropletRoot struct {
+// MemoryMB returns the Droplet's memory in megabytes.
+func (d Droplet) MemoryMB() int {
+	return d.Memory
+}
+
+// MemoryGB returns the Droplet's memory in gigabytes, where a gigabyte is 1024
+// megabytes.
+func (d Droplet) MemoryGB() float64 {
+	return float64(d.Memory) / 1024
+}
+
+// DiskGB returns the size of the Droplet's disk in gigabytes.
+func (d Droplet) DiskGB() int {
+	return d.Disk
+}
+
+// FeatureEnabled reports whether the named feature, such as "ipv6", is
+// enabled for the Droplet.
+func (d Droplet) FeatureEnabled(name string) bool {
+	for _, feature := range d.Features {
+		if feature == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasIPv6 reports whether IPv6 is enabled for the Droplet.
+func (d Droplet) HasIPv6() bool {
+	return d.FeatureEnabled("ipv6")
+}
+
+// HasPrivateNetworking reports whether private networking is enabled for the
+// Droplet.
+func (d Droplet) HasPrivateNetworking() bool {
+	return d.FeatureEnabled("private_networking")
+}
+
+// HasMonitoring reports whether monitoring is enabled for the Droplet.
+func (d Droplet) HasMonitoring() bool {
+	return d.FeatureEnabled("monitoring")
+}
+
+// HasBackupsEnabled reports whether backups are enabled for the Droplet. The
+// "backups" feature is checked first, falling back to whether the Droplet has
+// any backups when features were not returned.
+func (d Droplet) HasBackupsEnabled() bool {
+	if d.Features == nil {
+		return len(d.BackupIDs) > 0
+	}
+
+	return d.FeatureEnabled("backups")
+}
+
+// ResolveSizeSlug returns the slug of the Droplet's size. Size.Slug is
+// preferred, falling back to SizeSlug when Size was not returned by the API.
+func (d Droplet) ResolveSizeSlug() (string, error) {
+	if d.Size != nil && d.Size.Slug != "" {
+		return d.Size.Slug, nil
+	}
+
+	if d.SizeSlug != "" {
+		return d.SizeSlug, nil
+	}
+
+	return "", errors.New("droplet has no size slug")
+}
+
+// PriceMonthly looks up the monthly price of the Droplet's size in sizes.
+func (d Droplet) PriceMonthly(sizes []Size) (float64, error) {
+	slug, err := d.ResolveSizeSlug()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, size := range sizes {
+		if size.Slug == slug {
+			return size.PriceMonthly, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown size: [%s]", slug)
+}
+
+type dropletRoot struct {
 	Droplet *Droplet `json:"droplet"`
 	Links   *Links   `json:"links,omitempty"`
 }
@@ -43,19 +157,82 @@ type dropletsRoot struct {
 	Droplets []Droplet `json:"droplets"`
 }
 
+type neighborsRoot struct {
+	Neighbors [][]Droplet `json:"neighbors"`
+}
+
+// Kernel represents a kernel a DigitalOcean Droplet can boot
+type Kernel struct {
+	ID      int    `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+func (k Kernel) String() string {
+	return Stringify(k)
+}
+
+type kernelsRoot struct {
+	Kernels []Kernel `json:"kernels"`
+}
+
 // DropletCreateRequest represents a request to create a droplet.
 type DropletCreateRequest struct {
-	Name    string        `json:"name"`
-	Region  string        `json:"region"`
-	Size    string        `json:"size"`
-	Image   string        `json:"image"`
-	SSHKeys []interface{} `json:"ssh_keys"`
+	Name              string        `json:"name"`
+	Region            string        `json:"region"`
+	Size              string        `json:"size"`
+	Image             ImageRef      `json:"image"`
+	SSHKeys           []interface{} `json:"ssh_keys"`
+	UserData          string        `json:"user_data,omitempty"`
+	WithDropletAgent  *bool         `json:"with_droplet_agent,omitempty"`
+	Tags              []string      `json:"tags,omitempty"`
+	PrivateNetworking bool          `json:"private_networking,omitempty"`
+	IPv6              bool          `json:"ipv6,omitempty"`
 }
 
 func (d DropletCreateRequest) String() string {
 	return Stringify(d)
 }
 
+// ImageRef identifies the image to create a droplet from, either by its slug
+// or by its id. Use ImageRefFromSlug or ImageRefFromID to make one.
+type ImageRef struct {
+	ID   int
+	Slug string
+}
+
+// ImageRefFromSlug returns an ImageRef to the image with the given slug, such
+// as "ubuntu-14-04-x64".
+func ImageRefFromSlug(slug string) ImageRef {
+	return ImageRef{Slug: slug}
+}
+
+// ImageRefFromID returns an ImageRef to the image with the given id, such as
+// one of the account's snapshots.
+func ImageRefFromID(id int) ImageRef {
+	return ImageRef{ID: id}
+}
+
+// MarshalJSON encodes the image as its id, a JSON number, if it has one, and
+// as its slug, a JSON string, otherwise.
+func (i ImageRef) MarshalJSON() ([]byte, error) {
+	if i.ID != 0 {
+		return json.Marshal(i.ID)
+	}
+
+	return json.Marshal(i.Slug)
+}
+
+// UnmarshalJSON decodes an image given as either an id or a slug.
+func (i *ImageRef) UnmarshalJSON(data []byte) error {
+	*i = ImageRef{}
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &i.Slug)
+	}
+
+	return json.Unmarshal(data, &i.ID)
+}
+
 // Networks represents the droplet's networks
 type Networks struct {
 	V4 []Network `json:"v4,omitempty"`
@@ -81,9 +258,9 @@ type Links struct {
 
 // Action extracts Link
 func (l *Links) Action(action string) *Link {
-	for _, a := range l.Actions {
-		if a.Rel == action {
-			return &a
+	for i := range l.Actions {
+		if l.Actions[i].Rel == action {
+			return &l.Actions[i]
 		}
 	}
 
@@ -97,11 +274,79 @@ type Link struct {
 	HREF string `json:"href,omitempty"`
 }
 
-// List all droplets
-func (s *DropletsService) List() ([]Droplet, *Response, error) {
-	path := dropletBasePath
+// List droplets, one page at a time. A nil opt requests the first page; use
+// the returned Response's NextPage to continue.
+func (s *DropletsService) List(ctx context.Context, opt *ListOptions) ([]Droplet, *Response, error) {
+	path, err := addOptions(dropletBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.list(ctx, path)
+}
+
+// ListFrom lists one page of droplets, resuming from a token made by
+// marshalling a PaginationState, such as the one returned by
+// Response.NextPageState. An empty token requests the first page.
+func (s *DropletsService) ListFrom(ctx context.Context, token string) ([]Droplet, *Response, error) {
+	var opt *ListOptions
+	if token != "" {
+		state := new(PaginationState)
+		if err := state.UnmarshalText([]byte(token)); err != nil {
+			return nil, nil, err
+		}
+		opt = state.ListOptions()
+	}
+
+	return s.List(ctx, opt)
+}
 
-	req, err := s.client.NewRequest("GET", path, nil)
+// ListByTag lists the droplets tagged with tag, one page at a time. A nil opt
+// requests the first page.
+func (s *DropletsService) ListByTag(ctx context.Context, tag string, opt *ListOptions) ([]Droplet, *Response, error) {
+	path := fmt.Sprintf("%s?tag_name=%s", dropletBasePath, url.QueryEscape(tag))
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.list(ctx, path)
+}
+
+// ListAll lists every droplet, following the next page links until the last
+// page. The Response returned is that of the last page requested.
+func (s *DropletsService) ListAll(ctx context.Context) ([]Droplet, *Response, error) {
+	var all []Droplet
+	resp, err := listPages(dropletBasePath, func(path string) (*Response, bool, error) {
+		droplets, resp, err := s.list(ctx, path)
+		all = append(all, droplets...)
+		return resp, false, err
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return all, resp, nil
+}
+
+// CountByStatus lists every droplet and returns the number of droplets in each
+// status, such as "active" or "off".
+func (s *DropletsService) CountByStatus(ctx context.Context) (map[string]int, error) {
+	droplets, _, err := s.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+	for _, d := range droplets {
+		counts[d.Status]++
+	}
+
+	return counts, nil
+}
+
+func (s *DropletsService) list(ctx context.Context, path string) ([]Droplet, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -115,47 +360,264 @@ func (s *DropletsService) List() ([]Droplet, *Response, error) {
 	return droplets.Droplets, resp, err
 }
 
-// Get individual droplet
-func (s *DropletsService) Get(dropletID int) (*DropletRoot, *Response, error) {
+// Get individual droplet. Links to the droplet's actions are available from the
+// returned Response.
+func (s *DropletsService) Get(ctx context.Context, dropletID int) (*Droplet, *Response, error) {
 	path := fmt.Sprintf("%s/%d", dropletBasePath, dropletID)
 
-	req, err := s.client.NewRequest("GET", path, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	root := new(DropletRoot)
+	root := new(dropletRoot)
 	resp, err := s.client.Do(req, root)
 	if err != nil {
 		return nil, resp, err
 	}
+	resp.Links = root.Links
 
-	return root, resp, err
+	return root.Droplet, resp, err
 }
 
-// Create droplet
-func (s *DropletsService) Create(createRequest *DropletCreateRequest) (*DropletRoot, *Response, error) {
+// Create droplet. Links to the actions started by the create, such as the
+// "create" action, are available from the returned Response.
+//
+// If the request enables private networking or IPv6, the region is first
+// checked to support them, and an error describing the missing feature is
+// returned if it doesn't. The check is skipped if the regions can't be listed.
+func (s *DropletsService) Create(ctx context.Context, createRequest *DropletCreateRequest) (*Droplet, *Response, error) {
+	if err := s.checkRegionFeatures(ctx, createRequest); err != nil {
+		return nil, nil, err
+	}
+
 	path := dropletBasePath
 
-	req, err := s.client.NewRequest("POST", path, createRequest)
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, createRequest)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	root := new(DropletRoot)
+	root := new(dropletRoot)
 	resp, err := s.client.Do(req, root)
 	if err != nil {
 		return nil, resp, err
 	}
+	resp.Links = root.Links
 
-	return root, resp, err
+	return root.Droplet, resp, err
+}
+
+// checkRegionFeatures returns an error if createRequest enables a feature its
+// region doesn't support.
+func (s *DropletsService) checkRegionFeatures(ctx context.Context, createRequest *DropletCreateRequest) error {
+	var features []string
+	if createRequest.PrivateNetworking {
+		features = append(features, "private_networking")
+	}
+	if createRequest.IPv6 {
+		features = append(features, "ipv6")
+	}
+	if len(features) == 0 {
+		return nil
+	}
+
+	regions, _, err := s.client.Regions.List(ctx)
+	if err != nil {
+		return nil
+	}
+
+	for _, region := range regions {
+		if region.Slug != createRequest.Region {
+			continue
+		}
+
+		for _, feature := range features {
+			if !region.HasFeature(feature) {
+				return fmt.Errorf("region %s does not support %s", region.Slug, feature)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateWithAllKeys creates a droplet that trusts every SSH key on the
+// account, in addition to any keys already in createRequest.SSHKeys.
+// createRequest itself is left unchanged.
+func (s *DropletsService) CreateWithAllKeys(ctx context.Context, createRequest *DropletCreateRequest) (*Droplet, *Response, error) {
+	keys, resp, err := s.client.Keys.ListAll(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	request := *createRequest
+	request.SSHKeys = append([]interface{}(nil), createRequest.SSHKeys...)
+	for _, key := range keys {
+		request.SSHKeys = append(request.SSHKeys, key.Fingerprint)
+	}
+
+	return s.Create(ctx, &request)
+}
+
+// CreateWithAction creates a droplet and fetches the action tracking its
+// creation from the links in the response. The Response returned is that of the
+// create request. If the action can't be fetched, the created droplet is still
+// returned along with the error.
+func (s *DropletsService) CreateWithAction(ctx context.Context, createRequest *DropletCreateRequest) (*Droplet, *Action, *Response, error) {
+	droplet, resp, err := s.Create(ctx, createRequest)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+
+	var link *Link
+	if resp.Links != nil {
+		link = resp.Links.Action("create")
+	}
+	if link == nil {
+		return droplet, nil, resp, errors.New("create response has no create action link")
+	}
+
+	action, _, err := s.client.DropletActions.GetByURI(ctx, link.HREF)
+	if err != nil {
+		return droplet, nil, resp, err
+	}
+
+	return droplet, action, resp, nil
+}
+
+// ErrDeleteTokenMismatch is returned by ConfirmDelete when the droplet no
+// longer matches the token returned by PrepareDelete.
+var ErrDeleteTokenMismatch = errors.New("godo: droplet changed since PrepareDelete")
+
+// PrepareDelete returns a token identifying the droplet as it is now, to be
+// passed to ConfirmDelete.
+func (s *DropletsService) PrepareDelete(ctx context.Context, dropletID int) (string, *Response, error) {
+	droplet, resp, err := s.Get(ctx, dropletID)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return deleteToken(droplet), resp, nil
+}
+
+// ConfirmDelete deletes the droplet only if it still matches token, as
+// returned by PrepareDelete. This guards against deleting a different droplet
+// that has since been given the same id. If the droplet has changed, nothing is
+// deleted and ErrDeleteTokenMismatch is returned.
+func (s *DropletsService) ConfirmDelete(ctx context.Context, dropletID int, token string) (*Response, error) {
+	droplet, resp, err := s.Get(ctx, dropletID)
+	if err != nil {
+		return resp, err
+	}
+
+	if deleteToken(droplet) != token {
+		return resp, ErrDeleteTokenMismatch
+	}
+
+	return s.Delete(ctx, dropletID)
+}
+
+// deleteToken identifies a droplet by its id, name and creation time.
+func deleteToken(d *Droplet) string {
+	var created string
+	if d.CreatedAt != nil {
+		created = d.CreatedAt.UTC().Format(time.RFC3339)
+	}
+
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d\x00%s\x00%s", d.ID, d.Name, created)))
+	return hex.EncodeToString(sum[:])
+}
+
+// Ensure returns the droplet named createRequest.Name, creating it from
+// createRequest if no droplet has that name. While the droplet is still being
+// created, with status "new", Ensure waits for it to become active, polling at
+// the client's ActionPollInterval, and returns its state once it is. An
+// existing droplet in any other status, such as "off", is returned as is,
+// since it won't become active on its own.
+func (s *DropletsService) Ensure(ctx context.Context, createRequest *DropletCreateRequest) (*Droplet, *Response, error) {
+	droplet, resp, err := s.getByName(ctx, createRequest.Name)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if droplet == nil {
+		droplet, resp, err = s.Create(ctx, createRequest)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	if droplet.Status != "new" {
+		return droplet, resp, nil
+	}
+
+	err = s.WatchStatus(ctx, droplet.ID, 0, func(string) {})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.Get(ctx, droplet.ID)
+}
+
+// DropletNotFoundError is returned by GetByName when no droplet has the
+// requested name.
+type DropletNotFoundError struct {
+	Name string
+}
+
+func (e *DropletNotFoundError) Error() string {
+	return fmt.Sprintf("no droplet named %q", e.Name)
+}
+
+// GetByName lists every droplet and returns the first one named name, or a
+// *DropletNotFoundError if there is none. Droplet names aren't unique; use
+// GetAllByName to get every match.
+func (s *DropletsService) GetByName(ctx context.Context, name string) (*Droplet, *Response, error) {
+	droplet, resp, err := s.getByName(ctx, name)
+	if err != nil {
+		return nil, resp, err
+	}
+	if droplet == nil {
+		return nil, resp, &DropletNotFoundError{Name: name}
+	}
+
+	return droplet, resp, nil
+}
+
+// GetAllByName lists every droplet and returns those named name, in the order
+// they were listed.
+func (s *DropletsService) GetAllByName(ctx context.Context, name string) ([]Droplet, *Response, error) {
+	droplets, resp, err := s.ListAll(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var matches []Droplet
+	for _, d := range droplets {
+		if d.Name == name {
+			matches = append(matches, d)
+		}
+	}
+
+	return matches, resp, nil
+}
+
+// getByName returns the first droplet named name, or nil if there is none.
+func (s *DropletsService) getByName(ctx context.Context, name string) (*Droplet, *Response, error) {
+	matches, resp, err := s.GetAllByName(ctx, name)
+	if err != nil || len(matches) == 0 {
+		return nil, resp, err
+	}
+
+	return &matches[0], resp, nil
 }
 
 // Delete droplet
-func (s *DropletsService) Delete(dropletID int) (*Response, error) {
+func (s *DropletsService) Delete(ctx context.Context, dropletID int) (*Response, error) {
 	path := fmt.Sprintf("%s/%d", dropletBasePath, dropletID)
 
-	req, err := s.client.NewRequest("DELETE", path, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -165,8 +627,179 @@ func (s *DropletsService) Delete(dropletID int) (*Response, error) {
 	return resp, err
 }
 
-func (s *DropletsService) dropletActionStatus(uri string) (string, error) {
-	action, _, err := s.client.DropletActions.GetByURI(uri)
+// MoveToProject assigns a droplet to a project, moving it out of the project
+// it was in.
+func (s *DropletsService) MoveToProject(ctx context.Context, dropletID int, projectID string) (*Response, error) {
+	_, resp, err := s.client.Projects.AssignResources(ctx, projectID, dropletURN(dropletID))
+	return resp, err
+}
+
+// dropletURN returns the URN that identifies a droplet across services, such
+// as projects.
+func dropletURN(dropletID int) string {
+	return fmt.Sprintf("do:droplet:%d", dropletID)
+}
+
+// Snapshots lists the snapshots of a droplet, one page at a time. A nil opt
+// requests the first page.
+func (s *DropletsService) Snapshots(ctx context.Context, dropletID int, opt *ListOptions) ([]Image, *Response, error) {
+	path := fmt.Sprintf("%s/%d/snapshots", dropletBasePath, dropletID)
+	return s.listImages(ctx, path, opt)
+}
+
+// Backups lists the backups of a droplet, one page at a time. A nil opt
+// requests the first page.
+func (s *DropletsService) Backups(ctx context.Context, dropletID int, opt *ListOptions) ([]Image, *Response, error) {
+	path := fmt.Sprintf("%s/%d/backups", dropletBasePath, dropletID)
+	return s.listImages(ctx, path, opt)
+}
+
+// BackupImages returns the backups of a droplet as images, most recent first
+func (s *DropletsService) BackupImages(ctx context.Context, dropletID int) ([]Image, *Response, error) {
+	backups, resp, err := s.Backups(ctx, dropletID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		if backups[j].CreatedAt == nil {
+			return backups[i].CreatedAt != nil
+		}
+		return backups[i].CreatedAt != nil && backups[i].CreatedAt.After(backups[j].CreatedAt.Time)
+	})
+
+	return backups, resp, err
+}
+
+func (s *DropletsService) listImages(ctx context.Context, path string, opt *ListOptions) ([]Image, *Response, error) {
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(imagesRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Images, resp, err
+}
+
+// Kernels lists the kernels available to a droplet, one page at a time. A nil
+// opt requests the first page.
+func (s *DropletsService) Kernels(ctx context.Context, dropletID int, opt *ListOptions) ([]Kernel, *Response, error) {
+	path := fmt.Sprintf("%s/%d/kernels", dropletBasePath, dropletID)
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(kernelsRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Kernels, resp, err
+}
+
+// Actions lists the action history of a droplet, one page at a time. A nil
+// opt requests the first page.
+func (s *DropletsService) Actions(ctx context.Context, dropletID int, opt *ListOptions) ([]Action, *Response, error) {
+	path, err := addOptions(dropletActionPath(dropletID), opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionsRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Actions, resp, err
+}
+
+// Neighbors lists the droplets running on the same physical server as the
+// given droplet.
+func (s *DropletsService) Neighbors(ctx context.Context, dropletID int) ([]Droplet, *Response, error) {
+	path := fmt.Sprintf("%s/%d/neighbors", dropletBasePath, dropletID)
+	return s.list(ctx, path)
+}
+
+// AllNeighbors lists every group of droplets that share a physical server.
+// Each group holds two or more droplets.
+func (s *DropletsService) AllNeighbors(ctx context.Context) ([][]Droplet, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", "v2/reports/droplet_neighbors", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(neighborsRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Neighbors, resp, err
+}
+
+// WatchStatus polls the droplet every pollInterval and calls fn each time its
+// status changes. If pollInterval is zero the client's ActionPollInterval is
+// used. It returns once the droplet is active, or with the context's error if
+// ctx is done first.
+func (s *DropletsService) WatchStatus(ctx context.Context, dropletID int, pollInterval time.Duration, fn func(status string)) error {
+	if pollInterval <= 0 {
+		pollInterval = s.client.ActionPollInterval
+	}
+
+	var last string
+	for {
+		droplet, _, err := s.Get(ctx, dropletID)
+		if err != nil {
+			return err
+		}
+
+		var status string
+		if droplet != nil {
+			status = droplet.Status
+		}
+
+		if status != last {
+			fn(status)
+			last = status
+		}
+
+		if status == "active" {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (s *DropletsService) dropletActionStatus(ctx context.Context, uri string) (string, error) {
+	action, _, err := s.client.DropletActions.GetByURI(ctx, uri)
 
 	if err != nil {
 		return "", err
@@ -1,11 +1,15 @@
 package godo
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestDroplets_ListDroplets(t *testing.T) {
@@ -17,7 +21,7 @@ func TestDroplets_ListDroplets(t *testing.T) {
 		fmt.Fprint(w, `{"droplets": [{"id":1},{"id":2}]}`)
 	})
 
-	droplets, _, err := client.Droplet.List()
+	droplets, _, err := client.Droplet.List(ctx, nil)
 	if err != nil {
 		t.Errorf("Droplets.List returned error: %v", err)
 	}
@@ -28,57 +32,908 @@ func TestDroplets_ListDroplets(t *testing.T) {
 	}
 }
 
+func TestDroplets_ListDropletsMultiplePages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2", "per_page": "1"})
+		w.Header().Set("Link", `<https://api.digitalocean.com/v2/droplets?page=3&per_page=1>; rel="next", `+
+			`<https://api.digitalocean.com/v2/droplets?page=1&per_page=1>; rel="prev"`)
+		fmt.Fprint(w, `{"droplets": [{"id":2}]}`)
+	})
+
+	droplets, resp, err := client.Droplet.List(ctx, &ListOptions{Page: 2, PerPage: 1})
+	if err != nil {
+		t.Errorf("Droplets.List returned error: %v", err)
+	}
+
+	expected := []Droplet{{ID: 2}}
+	if !reflect.DeepEqual(droplets, expected) {
+		t.Errorf("Droplets.List returned %+v, expected %+v", droplets, expected)
+	}
+
+	if expected := "https://api.digitalocean.com/v2/droplets?page=3&per_page=1"; resp.NextPage != expected {
+		t.Errorf("Droplets.List NextPage = %v, expected %v", resp.NextPage, expected)
+	}
+	if expected := "https://api.digitalocean.com/v2/droplets?page=1&per_page=1"; resp.PrevPage != expected {
+		t.Errorf("Droplets.List PrevPage = %v, expected %v", resp.PrevPage, expected)
+	}
+}
+
+func TestDroplets_ListByTag(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"tag_name": "web", "page": "2", "per_page": "1"})
+		fmt.Fprint(w, `{"droplets": [{"id":2,"tags":["web"]}]}`)
+	})
+
+	droplets, _, err := client.Droplet.ListByTag(ctx, "web", &ListOptions{Page: 2, PerPage: 1})
+	if err != nil {
+		t.Errorf("Droplets.ListByTag returned error: %v", err)
+	}
+
+	expected := []Droplet{{ID: 2, Tags: []string{"web"}}}
+	if !reflect.DeepEqual(droplets, expected) {
+		t.Errorf("Droplets.ListByTag returned %+v, expected %+v", droplets, expected)
+	}
+}
+
+func TestDroplets_ListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"droplets": [{"id":3}]}`)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/v2/droplets?page=2>; rel="next"`, server.URL))
+		fmt.Fprint(w, `{"droplets": [{"id":1},{"id":2}]}`)
+	})
+
+	droplets, resp, err := client.Droplet.ListAll(ctx)
+	if err != nil {
+		t.Errorf("Droplets.ListAll returned error: %v", err)
+	}
+
+	expected := []Droplet{{ID: 1}, {ID: 2}, {ID: 3}}
+	if !reflect.DeepEqual(droplets, expected) {
+		t.Errorf("Droplets.ListAll returned %+v, expected %+v", droplets, expected)
+	}
+
+	if resp.NextPage != "" {
+		t.Errorf("Droplets.ListAll NextPage = %v, expected none", resp.NextPage)
+	}
+}
+
+func TestDroplets_ListFrom(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/v2/droplets?page=2&per_page=2>; rel="next"`, server.URL))
+			fmt.Fprint(w, `{"droplets": [{"id":1},{"id":2}]}`)
+		case "2":
+			testFormValues(t, r, values{"page": "2", "per_page": "2"})
+			fmt.Fprint(w, `{"droplets": [{"id":3}]}`)
+		}
+	})
+
+	_, resp, err := client.Droplet.ListFrom(ctx, "")
+	if err != nil {
+		t.Fatalf("Droplets.ListFrom returned error: %v", err)
+	}
+
+	state, err := resp.NextPageState()
+	if err != nil {
+		t.Fatalf("Response.NextPageState returned error: %v", err)
+	}
+	token, err := state.MarshalText()
+	if err != nil {
+		t.Fatalf("PaginationState.MarshalText returned error: %v", err)
+	}
+
+	droplets, resp, err := client.Droplet.ListFrom(ctx, string(token))
+	if err != nil {
+		t.Fatalf("Droplets.ListFrom returned error: %v", err)
+	}
+
+	expected := []Droplet{{ID: 3}}
+	if !reflect.DeepEqual(droplets, expected) {
+		t.Errorf("Droplets.ListFrom returned %+v, expected %+v", droplets, expected)
+	}
+
+	if state, _ := resp.NextPageState(); state != nil {
+		t.Errorf("Response.NextPageState = %+v, expected nil on the last page", state)
+	}
+}
+
+func TestDroplets_ListFrom_invalidToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, _, err := client.Droplet.ListFrom(ctx, "not a token")
+	if err != ErrInvalidPaginationToken {
+		t.Errorf("Droplets.ListFrom returned error %v, expected %v", err, ErrInvalidPaginationToken)
+	}
+}
+
+func TestDroplets_ListAll_selfReferentialNext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		next := fmt.Sprintf("%s/v2/droplets?page=2", server.URL)
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next))
+		fmt.Fprintf(w, `{"droplets": [{"id":%d}]}`, requests)
+	})
+
+	droplets, _, err := client.Droplet.ListAll(ctx)
+	if err != nil {
+		t.Errorf("Droplets.ListAll returned error: %v", err)
+	}
+
+	expected := []Droplet{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(droplets, expected) {
+		t.Errorf("Droplets.ListAll returned %+v, expected %+v", droplets, expected)
+	}
+}
+
+func TestDroplets_CountByStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"droplets": [{"id":4,"status":"active"}]}`)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/v2/droplets?page=2>; rel="next"`, server.URL))
+		fmt.Fprint(w, `{"droplets": [{"id":1,"status":"active"},{"id":2,"status":"off"},{"id":3,"status":"new"}]}`)
+	})
+
+	counts, err := client.Droplet.CountByStatus(ctx)
+	if err != nil {
+		t.Errorf("Droplets.CountByStatus returned error: %v", err)
+	}
+
+	expected := map[string]int{"active": 2, "off": 1, "new": 1}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("Droplets.CountByStatus returned %+v, expected %+v", counts, expected)
+	}
+}
+
 func TestDroplets_GetDroplet(t *testing.T) {
 	setup()
 	defer teardown()
 
-	mux.HandleFunc("/v2/droplets/12345", func(w http.ResponseWriter, r *http.Request) {
+	mux.HandleFunc("/v2/droplets/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"droplet":{"id":12345}}`)
+	})
+
+	droplets, _, err := client.Droplet.Get(ctx, 12345)
+	if err != nil {
+		t.Errorf("Droplet.Get returned error: %v", err)
+	}
+
+	expected := &Droplet{ID: 12345}
+	if !reflect.DeepEqual(droplets, expected) {
+		t.Errorf("Droplets.Get returned %+v, expected %+v", droplets, expected)
+	}
+}
+
+func TestDroplets_GetDroplet_diskInfo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"droplet":{"id":12345,"disk_info":[
+			{"type":"local","size":{"amount":25,"unit":"gib"}},
+			{"type":"scratch","size":{"amount":40,"unit":"gib"}}
+		]}}`)
+	})
+
+	droplet, _, err := client.Droplet.Get(ctx, 12345)
+	if err != nil {
+		t.Errorf("Droplet.Get returned error: %v", err)
+	}
+
+	expected := []DiskInfo{
+		{Type: "local", Size: DiskSize{Amount: 25, Unit: "gib"}},
+		{Type: "scratch", Size: DiskSize{Amount: 40, Unit: "gib"}},
+	}
+	if !reflect.DeepEqual(droplet.DiskInfo, expected) {
+		t.Errorf("Droplet.DiskInfo = %+v, expected %+v", droplet.DiskInfo, expected)
+	}
+}
+
+func TestDroplets_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &DropletCreateRequest{
+		Name:   "name",
+		Region: "region",
+		Size:   "size",
+		Image:  ImageRefFromID(1),
+	}
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		v := new(DropletCreateRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, createRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, createRequest)
+		}
+
+		fmt.Fprintf(w, `{"droplet":{"id":1}}`)
+	})
+
+	droplet, _, err := client.Droplet.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Droplets.Create returned error: %v", err)
+	}
+
+	expected := &Droplet{ID: 1}
+	if !reflect.DeepEqual(droplet, expected) {
+		t.Errorf("Droplets.Create returned %+v, expected %+v", droplet, expected)
+	}
+}
+
+func TestDroplets_Create_unsupportedRegionFeature(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/regions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"regions":[`+
+			`{"slug":"nyc1","features":["backups","ipv6"]},`+
+			`{"slug":"nyc2","features":["backups","ipv6","private_networking"]}]}`)
+	})
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Droplets.Create sent a request the region can't serve")
+	})
+
+	createRequest := &DropletCreateRequest{Name: "name", Region: "nyc1", PrivateNetworking: true}
+	_, _, err := client.Droplet.Create(ctx, createRequest)
+
+	if err == nil || !strings.Contains(err.Error(), "private_networking") {
+		t.Errorf("Expected an error naming private_networking; got %v", err)
+	}
+}
+
+func TestDroplets_Create_supportedRegionFeature(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/regions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"regions":[{"slug":"nyc2","features":["ipv6","private_networking"]}]}`)
+	})
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"droplet":{"id":1}}`)
+	})
+
+	createRequest := &DropletCreateRequest{Name: "name", Region: "nyc2", PrivateNetworking: true, IPv6: true}
+	droplet, _, err := client.Droplet.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Droplets.Create returned error: %v", err)
+	}
+
+	if expected := (&Droplet{ID: 1}); !reflect.DeepEqual(droplet, expected) {
+		t.Errorf("Droplets.Create returned %+v, expected %+v", droplet, expected)
+	}
+}
+
+func TestDroplets_Create_accepted(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"droplet":{"id":1,"status":"new"}}`)
+	})
+
+	droplet, resp, err := client.Droplet.Create(ctx, &DropletCreateRequest{Name: "name"})
+	if err != nil {
+		t.Errorf("Droplets.Create returned error: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusAccepted {
+		t.Errorf("Droplets.Create status = %d, expected %d", resp.StatusCode, http.StatusAccepted)
+	}
+
+	expected := &Droplet{ID: 1, Status: "new"}
+	if !reflect.DeepEqual(droplet, expected) {
+		t.Errorf("Droplets.Create returned %+v, expected %+v", droplet, expected)
+	}
+}
+
+func TestDroplets_CreateWithAllKeys(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account/keys", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"ssh_keys":[{"id":3,"fingerprint":"cc:cc"}]}`)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/v2/account/keys?page=2>; rel="next"`, server.URL))
+		fmt.Fprint(w, `{"ssh_keys":[{"id":1,"fingerprint":"aa:aa"},{"id":2,"fingerprint":"bb:bb"}]}`)
+	})
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		v := new(DropletCreateRequest)
+		json.NewDecoder(r.Body).Decode(v)
+		expected := []interface{}{float64(123), "aa:aa", "bb:bb", "cc:cc"}
+		if !reflect.DeepEqual(v.SSHKeys, expected) {
+			t.Errorf("Request SSHKeys = %+v, expected %+v", v.SSHKeys, expected)
+		}
+
+		fmt.Fprint(w, `{"droplet":{"id":1}}`)
+	})
+
+	createRequest := &DropletCreateRequest{Name: "name", SSHKeys: []interface{}{123}}
+	droplet, _, err := client.Droplet.CreateWithAllKeys(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Droplets.CreateWithAllKeys returned error: %v", err)
+	}
+
+	if expected := (&Droplet{ID: 1}); !reflect.DeepEqual(droplet, expected) {
+		t.Errorf("Droplets.CreateWithAllKeys returned %+v, expected %+v", droplet, expected)
+	}
+	if len(createRequest.SSHKeys) != 1 {
+		t.Errorf("Droplets.CreateWithAllKeys modified the request's SSHKeys: %+v", createRequest.SSHKeys)
+	}
+}
+
+func TestDroplets_CreateWithAction(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &DropletCreateRequest{
+		Name:   "name",
+		Region: "region",
+		Size:   "size",
+		Image:  ImageRefFromID(1),
+	}
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprintf(w, `{"droplet":{"id":1},"links":{"actions":[`+
+			`{"id":2,"rel":"create","href":"%s/v2/actions/2"}]}}`, server.URL)
+	})
+
+	mux.HandleFunc("/v2/actions/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"action":{"id":2,"status":"in-progress"}}`)
+	})
+
+	droplet, action, _, err := client.Droplet.CreateWithAction(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Droplets.CreateWithAction returned error: %v", err)
+	}
+
+	if expected := (&Droplet{ID: 1}); !reflect.DeepEqual(droplet, expected) {
+		t.Errorf("Droplets.CreateWithAction returned droplet %+v, expected %+v", droplet, expected)
+	}
+	if expected := (&Action{ID: 2, Status: "in-progress"}); !reflect.DeepEqual(action, expected) {
+		t.Errorf("Droplets.CreateWithAction returned action %+v, expected %+v", action, expected)
+	}
+}
+
+func TestDroplets_CreateWithAction_noLink(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"droplet":{"id":1}}`)
+	})
+
+	droplet, action, _, err := client.Droplet.CreateWithAction(ctx, &DropletCreateRequest{Name: "name"})
+	if err == nil {
+		t.Error("Expected error to be returned.")
+	}
+	if droplet == nil || droplet.ID != 1 {
+		t.Errorf("Droplets.CreateWithAction returned droplet %+v, expected the created droplet", droplet)
+	}
+	if action != nil {
+		t.Errorf("Droplets.CreateWithAction returned action %+v, expected nil", action)
+	}
+}
+
+func TestDroplets_Create_links(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"droplet":{"id":1},"links":{"actions":[`+
+			`{"id":2,"rel":"create","href":"https://api.digitalocean.com/v2/actions/2"}]}}`)
+	})
+
+	_, resp, err := client.Droplet.Create(ctx, &DropletCreateRequest{Name: "name"})
+	if err != nil {
+		t.Errorf("Droplets.Create returned error: %v", err)
+	}
+
+	expected := &Links{Actions: []Link{{ID: 2, Rel: "create", HREF: "https://api.digitalocean.com/v2/actions/2"}}}
+	if !reflect.DeepEqual(resp.Links, expected) {
+		t.Errorf("Droplets.Create Response.Links = %+v, expected %+v", resp.Links, expected)
+	}
+}
+
+func TestDroplets_Create_tags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &DropletCreateRequest{
+		Name:   "name",
+		Region: "region",
+		Size:   "size",
+		Image:  ImageRefFromID(1),
+		Tags:   []string{"web", "prod"},
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request %v %v", r.Method, r.URL)
+	})
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		v := new(DropletCreateRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, createRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, createRequest)
+		}
+
+		fmt.Fprintf(w, `{"droplet":{"id":1}}`)
+	})
+
+	_, _, err := client.Droplet.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Droplets.Create returned error: %v", err)
+	}
+}
+
+func TestDropletCreateRequest_WithDropletAgent(t *testing.T) {
+	tests := []struct {
+		agent    *bool
+		expected string
+	}{
+		{nil, `{"name":"name","region":"","size":"","image":"","ssh_keys":null}`},
+		{Bool(false), `{"name":"name","region":"","size":"","image":"","ssh_keys":null,"with_droplet_agent":false}`},
+		{Bool(true), `{"name":"name","region":"","size":"","image":"","ssh_keys":null,"with_droplet_agent":true}`},
+	}
+
+	c := NewClient(nil)
+	for _, tt := range tests {
+		createRequest := &DropletCreateRequest{Name: "name", WithDropletAgent: tt.agent}
+		req, _ := c.NewRequest("POST", dropletBasePath, createRequest)
+
+		body, _ := ioutil.ReadAll(req.Body)
+		if got := strings.TrimSpace(string(body)); got != tt.expected {
+			t.Errorf("Request body = %v, expected %v", got, tt.expected)
+		}
+	}
+}
+
+func TestDroplets_Snapshots(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/12345/snapshots", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"images":[{"id":1,"name":"snap-1"},{"id":2,"name":"snap-2"}]}`)
+	})
+
+	snapshots, _, err := client.Droplet.Snapshots(ctx, 12345, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Droplets.Snapshots returned error: %v", err)
+	}
+
+	expected := []Image{{ID: 1, Name: "snap-1"}, {ID: 2, Name: "snap-2"}}
+	if !reflect.DeepEqual(snapshots, expected) {
+		t.Errorf("Droplets.Snapshots returned %+v, expected %+v", snapshots, expected)
+	}
+}
+
+func TestDroplets_Backups(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/12345/backups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"per_page": "1"})
+		fmt.Fprint(w, `{"images":[{"id":3,"name":"backup-1"}]}`)
+	})
+
+	backups, _, err := client.Droplet.Backups(ctx, 12345, &ListOptions{PerPage: 1})
+	if err != nil {
+		t.Errorf("Droplets.Backups returned error: %v", err)
+	}
+
+	expected := []Image{{ID: 3, Name: "backup-1"}}
+	if !reflect.DeepEqual(backups, expected) {
+		t.Errorf("Droplets.Backups returned %+v, expected %+v", backups, expected)
+	}
+}
+
+func TestDroplets_BackupImages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/12345/backups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"images":[`+
+			`{"id":1,"created_at":"2014-07-01T00:00:00Z"},`+
+			`{"id":3,"created_at":"2014-07-15T00:00:00Z"},`+
+			`{"id":2,"created_at":"2014-07-08T00:00:00Z"}]}`)
+	})
+
+	backups, _, err := client.Droplet.BackupImages(ctx, 12345)
+	if err != nil {
+		t.Errorf("Droplets.BackupImages returned error: %v", err)
+	}
+
+	var ids []int
+	for _, backup := range backups {
+		ids = append(ids, backup.ID)
+	}
+
+	expected := []int{3, 2, 1}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Droplets.BackupImages returned ids %v, expected %v", ids, expected)
+	}
+
+	latest := time.Date(2014, 7, 15, 0, 0, 0, 0, time.UTC)
+	if !backups[0].CreatedAt.Equal(Timestamp{latest}) {
+		t.Errorf("Droplets.BackupImages latest CreatedAt = %v, expected %v", backups[0].CreatedAt, latest)
+	}
+}
+
+func TestDroplets_ConfirmDelete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	deleted := false
+	mux.HandleFunc("/v2/droplets/12345", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			deleted = true
+			return
+		}
+		fmt.Fprint(w, `{"droplet":{"id":12345,"name":"web-1","created_at":"2014-05-08T20:36:47Z"}}`)
+	})
+
+	token, _, err := client.Droplet.PrepareDelete(ctx, 12345)
+	if err != nil {
+		t.Fatalf("Droplets.PrepareDelete returned error: %v", err)
+	}
+
+	_, err = client.Droplet.ConfirmDelete(ctx, 12345, token)
+	if err != nil {
+		t.Errorf("Droplets.ConfirmDelete returned error: %v", err)
+	}
+	if !deleted {
+		t.Error("Droplets.ConfirmDelete did not delete the droplet")
+	}
+}
+
+func TestDroplets_ConfirmDelete_changed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	created := "2014-05-08T20:36:47Z"
+	mux.HandleFunc("/v2/droplets/12345", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "DELETE" {
+			t.Error("Droplets.ConfirmDelete deleted a droplet that changed")
+			return
+		}
+		fmt.Fprintf(w, `{"droplet":{"id":12345,"name":"web-1","created_at":"%s"}}`, created)
+	})
+
+	token, _, err := client.Droplet.PrepareDelete(ctx, 12345)
+	if err != nil {
+		t.Fatalf("Droplets.PrepareDelete returned error: %v", err)
+	}
+
+	// the id is recycled for a new droplet of the same name
+	created = "2014-06-01T10:00:00Z"
+
+	_, err = client.Droplet.ConfirmDelete(ctx, 12345, token)
+	if err != ErrDeleteTokenMismatch {
+		t.Errorf("Expected ErrDeleteTokenMismatch; got %#v.", err)
+	}
+}
+
+func TestDroplets_Ensure_exists(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"droplets": [{"id":1,"name":"other","status":"active"},{"id":2,"name":"name","status":"active"}]}`)
+	})
+
+	droplet, _, err := client.Droplet.Ensure(ctx, &DropletCreateRequest{Name: "name"})
+	if err != nil {
+		t.Errorf("Droplets.Ensure returned error: %v", err)
+	}
+
+	expected := &Droplet{ID: 2, Name: "name", Status: "active"}
+	if !reflect.DeepEqual(droplet, expected) {
+		t.Errorf("Droplets.Ensure returned %+v, expected %+v", droplet, expected)
+	}
+}
+
+func TestDroplets_Ensure_existsOff(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"droplets": [{"id":2,"name":"name","status":"off"}]}`)
+	})
+	mux.HandleFunc("/v2/droplets/2", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Droplets.Ensure polled a droplet that is off")
+	})
+
+	droplet, _, err := client.Droplet.Ensure(ctx, &DropletCreateRequest{Name: "name"})
+	if err != nil {
+		t.Errorf("Droplets.Ensure returned error: %v", err)
+	}
+
+	expected := &Droplet{ID: 2, Name: "name", Status: "off"}
+	if !reflect.DeepEqual(droplet, expected) {
+		t.Errorf("Droplets.Ensure returned %+v, expected %+v", droplet, expected)
+	}
+}
+
+func TestDroplets_Ensure_existsNew(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.ActionPollInterval = time.Millisecond
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "GET")
-		fmt.Fprint(w, `{"droplet":{"id":12345}}`)
+		fmt.Fprint(w, `{"droplets": [{"id":2,"name":"name","status":"new"}]}`)
+	})
+
+	statuses := []string{"new", "active"}
+	mux.HandleFunc("/v2/droplets/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"droplet":{"id":2,"name":"name","status":"%s"}}`, statuses[0])
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
 	})
 
-	droplets, _, err := client.Droplet.Get(12345)
+	droplet, _, err := client.Droplet.Ensure(ctx, &DropletCreateRequest{Name: "name"})
 	if err != nil {
-		t.Errorf("Droplet.Get returned error: %v", err)
+		t.Errorf("Droplets.Ensure returned error: %v", err)
 	}
 
-	expected := &DropletRoot{Droplet: &Droplet{ID: 12345}}
-	if !reflect.DeepEqual(droplets, expected) {
-		t.Errorf("Droplets.Get returned %+v, expected %+v", droplets, expected)
+	expected := &Droplet{ID: 2, Name: "name", Status: "active"}
+	if !reflect.DeepEqual(droplet, expected) {
+		t.Errorf("Droplets.Ensure returned %+v, expected %+v", droplet, expected)
 	}
 }
 
-func TestDroplets_Create(t *testing.T) {
+func TestDroplets_Ensure_create(t *testing.T) {
 	setup()
 	defer teardown()
 
-	createRequest := &DropletCreateRequest{
-		Name:   "name",
-		Region: "region",
-		Size:   "size",
-		Image:  "1",
-	}
+	client.ActionPollInterval = time.Millisecond
+
+	createRequest := &DropletCreateRequest{Name: "name", Region: "region", Size: "size", Image: ImageRefFromID(1)}
 
 	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
-		v := new(DropletCreateRequest)
-		json.NewDecoder(r.Body).Decode(v)
+		if r.Method == "GET" {
+			fmt.Fprint(w, `{"droplets": [{"id":1,"name":"other","status":"active"}]}`)
+			return
+		}
 
 		testMethod(t, r, "POST")
+		v := new(DropletCreateRequest)
+		json.NewDecoder(r.Body).Decode(v)
 		if !reflect.DeepEqual(v, createRequest) {
 			t.Errorf("Request body = %+v, expected %+v", v, createRequest)
 		}
+		fmt.Fprint(w, `{"droplet":{"id":2,"name":"name","status":"new"}}`)
+	})
 
-		fmt.Fprintf(w, `{"droplet":{"id":1}}`)
+	statuses := []string{"new", "active"}
+	mux.HandleFunc("/v2/droplets/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"droplet":{"id":2,"name":"name","status":"%s"}}`, statuses[0])
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
 	})
 
-	droplet, _, err := client.Droplet.Create(createRequest)
+	droplet, _, err := client.Droplet.Ensure(ctx, createRequest)
 	if err != nil {
-		t.Errorf("Droplets.Create returned error: %v", err)
+		t.Errorf("Droplets.Ensure returned error: %v", err)
 	}
 
-	expected := &DropletRoot{Droplet: &Droplet{ID: 1}}
+	expected := &Droplet{ID: 2, Name: "name", Status: "active"}
 	if !reflect.DeepEqual(droplet, expected) {
-		t.Errorf("Droplets.Create returned %+v, expected %+v", droplet, expected)
+		t.Errorf("Droplets.Ensure returned %+v, expected %+v", droplet, expected)
+	}
+}
+
+func TestDroplets_Kernels(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/12345/kernels", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"kernels": [`+
+			`{"id":61833229,"name":"Ubuntu 14.04 x64 vmlinuz-3.13.0-24-generic","version":"3.13.0-24-generic"},`+
+			`{"id":485432972,"name":"Ubuntu 14.04 x64 vmlinuz-3.13.0-32-generic","version":"3.13.0-32-generic"}]}`)
+	})
+
+	kernels, _, err := client.Droplet.Kernels(ctx, 12345, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Droplets.Kernels returned error: %v", err)
+	}
+
+	expected := []Kernel{
+		{ID: 61833229, Name: "Ubuntu 14.04 x64 vmlinuz-3.13.0-24-generic", Version: "3.13.0-24-generic"},
+		{ID: 485432972, Name: "Ubuntu 14.04 x64 vmlinuz-3.13.0-32-generic", Version: "3.13.0-32-generic"},
+	}
+	if !reflect.DeepEqual(kernels, expected) {
+		t.Errorf("Droplets.Kernels returned %+v, expected %+v", kernels, expected)
+	}
+}
+
+func TestDroplets_Actions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/12345/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"actions": [{"id":1,"type":"create","status":"completed"},{"id":2,"type":"reboot","status":"errored"}]}`)
+	})
+
+	actions, _, err := client.Droplet.Actions(ctx, 12345, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Droplets.Actions returned error: %v", err)
+	}
+
+	expected := []Action{{ID: 1, Type: "create", Status: "completed"}, {ID: 2, Type: "reboot", Status: "errored"}}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Errorf("Droplets.Actions returned %+v, expected %+v", actions, expected)
+	}
+}
+
+func TestDroplets_Neighbors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/12345/neighbors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"droplets": [{"id":1},{"id":2}]}`)
+	})
+
+	neighbors, _, err := client.Droplet.Neighbors(ctx, 12345)
+	if err != nil {
+		t.Errorf("Droplets.Neighbors returned error: %v", err)
+	}
+
+	expected := []Droplet{{ID: 1}, {ID: 2}}
+	if !reflect.DeepEqual(neighbors, expected) {
+		t.Errorf("Droplets.Neighbors returned %+v, expected %+v", neighbors, expected)
+	}
+}
+
+func TestDroplets_AllNeighbors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/reports/droplet_neighbors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"neighbors": [[{"id":1},{"id":2}],[{"id":3},{"id":4},{"id":5}]]}`)
+	})
+
+	neighbors, _, err := client.Droplet.AllNeighbors(ctx)
+	if err != nil {
+		t.Errorf("Droplets.AllNeighbors returned error: %v", err)
+	}
+
+	expected := [][]Droplet{{{ID: 1}, {ID: 2}}, {{ID: 3}, {ID: 4}, {ID: 5}}}
+	if !reflect.DeepEqual(neighbors, expected) {
+		t.Errorf("Droplets.AllNeighbors returned %+v, expected %+v", neighbors, expected)
+	}
+}
+
+func TestDroplets_WatchStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	statuses := []string{"new", "new", "active"}
+	mux.HandleFunc("/v2/droplets/12345", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, `{"droplet":{"id":12345,"status":"%s"}}`, statuses[0])
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+	})
+
+	var seen []string
+	err := client.Droplet.WatchStatus(context.Background(), 12345, time.Millisecond, func(status string) {
+		seen = append(seen, status)
+	})
+	if err != nil {
+		t.Errorf("Droplets.WatchStatus returned error: %v", err)
+	}
+
+	expected := []string{"new", "active"}
+	if !reflect.DeepEqual(seen, expected) {
+		t.Errorf("Droplets.WatchStatus saw %v, expected %v", seen, expected)
+	}
+}
+
+func TestDroplets_WatchStatus_defaultInterval(t *testing.T) {
+	setup()
+	defer teardown()
+
+	client.ActionPollInterval = 20 * time.Millisecond
+
+	statuses := []string{"new", "new", "active"}
+	mux.HandleFunc("/v2/droplets/12345", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"droplet":{"id":12345,"status":"%s"}}`, statuses[0])
+		statuses = statuses[1:]
+	})
+
+	start := time.Now()
+	err := client.Droplet.WatchStatus(context.Background(), 12345, 0, func(string) {})
+	if err != nil {
+		t.Errorf("Droplets.WatchStatus returned error: %v", err)
+	}
+
+	if elapsed, min := time.Since(start), 2*client.ActionPollInterval; elapsed < min {
+		t.Errorf("Droplets.WatchStatus took %v, expected at least %v", elapsed, min)
+	}
+}
+
+func TestDroplets_WatchStatus_cancel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets/12345", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"droplet":{"id":12345,"status":"new"}}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	err := client.Droplet.WatchStatus(ctx, 12345, time.Millisecond, func(status string) {
+		cancel()
+	})
+	if err != context.Canceled {
+		t.Errorf("Droplets.WatchStatus returned %v, expected %v", err, context.Canceled)
 	}
 }
 
@@ -90,12 +945,116 @@ func TestDroplets_Destroy(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Droplet.Delete(12345)
+	_, err := client.Droplet.Delete(ctx, 12345)
 	if err != nil {
 		t.Errorf("Droplet.Delete returned error: %v", err)
 	}
 }
 
+func TestDroplets_GetByName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"droplets": [{"id":3,"name":"web"},{"id":4,"name":"web"}]}`)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/v2/droplets?page=2>; rel="next"`, server.URL))
+		fmt.Fprint(w, `{"droplets": [{"id":1,"name":"db"},{"id":2,"name":"cache"}]}`)
+	})
+
+	droplet, _, err := client.Droplet.GetByName(ctx, "web")
+	if err != nil {
+		t.Errorf("Droplets.GetByName returned error: %v", err)
+	}
+
+	expected := &Droplet{ID: 3, Name: "web"}
+	if !reflect.DeepEqual(droplet, expected) {
+		t.Errorf("Droplets.GetByName returned %+v, expected %+v", droplet, expected)
+	}
+}
+
+func TestDroplets_GetByName_notFound(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"droplets": [{"id":1,"name":"db"}]}`)
+	})
+
+	droplet, _, err := client.Droplet.GetByName(ctx, "web")
+	if droplet != nil {
+		t.Errorf("Droplets.GetByName returned %+v, expected nil", droplet)
+	}
+
+	notFound, ok := err.(*DropletNotFoundError)
+	if !ok {
+		t.Fatalf("Droplets.GetByName returned error %#v, expected a *DropletNotFoundError", err)
+	}
+	if notFound.Name != "web" {
+		t.Errorf("DropletNotFoundError.Name = %q, expected %q", notFound.Name, "web")
+	}
+}
+
+func TestDroplets_GetAllByName(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"droplets": [{"id":3,"name":"web"}]}`)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/v2/droplets?page=2>; rel="next"`, server.URL))
+		fmt.Fprint(w, `{"droplets": [{"id":1,"name":"web"},{"id":2,"name":"db"}]}`)
+	})
+
+	droplets, _, err := client.Droplet.GetAllByName(ctx, "web")
+	if err != nil {
+		t.Errorf("Droplets.GetAllByName returned error: %v", err)
+	}
+
+	expected := []Droplet{{ID: 1, Name: "web"}, {ID: 3, Name: "web"}}
+	if !reflect.DeepEqual(droplets, expected) {
+		t.Errorf("Droplets.GetAllByName returned %+v, expected %+v", droplets, expected)
+	}
+
+	droplets, _, err = client.Droplet.GetAllByName(ctx, "cache")
+	if err != nil {
+		t.Errorf("Droplets.GetAllByName returned error: %v", err)
+	}
+	if len(droplets) != 0 {
+		t.Errorf("Droplets.GetAllByName returned %+v, expected none", droplets)
+	}
+}
+
+func TestDroplets_MoveToProject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	projectID := "4e1bfbc3-dc3e-41f2-a18f-1b4d7ba71679"
+	mux.HandleFunc("/v2/projects/"+projectID+"/resources", func(w http.ResponseWriter, r *http.Request) {
+		v := new(assignResourcesRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		expected := &assignResourcesRequest{Resources: []string{"do:droplet:12345"}}
+		if !reflect.DeepEqual(v, expected) {
+			t.Errorf("Request body = %+v, expected %+v", v, expected)
+		}
+
+		fmt.Fprint(w, `{"resources":[{"urn":"do:droplet:12345","status":"ok"}]}`)
+	})
+
+	_, err := client.Droplet.MoveToProject(ctx, 12345, projectID)
+	if err != nil {
+		t.Errorf("Droplets.MoveToProject returned error: %v", err)
+	}
+}
+
 func TestLinks_Actions(t *testing.T) {
 	setup()
 	defer teardown()
@@ -118,6 +1077,40 @@ func TestLinks_Actions(t *testing.T) {
 
 }
 
+func TestLinks_Actions_distinctPointers(t *testing.T) {
+	links := Links{
+		Actions: []Link{
+			{ID: 1, Rel: "a", HREF: "http://example.com/a"},
+			{ID: 2, Rel: "b", HREF: "http://example.com/b"},
+		},
+	}
+
+	a := links.Action("a")
+	b := links.Action("b")
+
+	if a == b {
+		t.Fatalf("Links.Action returned the same pointer for different rels")
+	}
+	if a != &links.Actions[0] || b != &links.Actions[1] {
+		t.Errorf("Links.Action returned pointers outside of Links.Actions")
+	}
+	if a.HREF != "http://example.com/a" || b.HREF != "http://example.com/b" {
+		t.Errorf("Links.Action returned %+v and %+v", a, b)
+	}
+}
+
+func TestLinks_Actions_missing(t *testing.T) {
+	links := Links{
+		Actions: []Link{
+			{ID: 1, Rel: "a", HREF: "http://example.com/a"},
+		},
+	}
+
+	if link := links.Action("b"); link != nil {
+		t.Errorf("Links.Action returned %+v, expected nil", link)
+	}
+}
+
 func TestNetwork_String(t *testing.T) {
 	network := &Network{
 		IPAddress: "192.168.1.2",
@@ -133,6 +1126,60 @@ func TestNetwork_String(t *testing.T) {
 
 }
 
+func TestImageRef_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		ref      ImageRef
+		expected string
+	}{
+		{ImageRefFromSlug("ubuntu-14-04-x64"), `"ubuntu-14-04-x64"`},
+		{ImageRefFromID(3240036), `3240036`},
+		{ImageRef{}, `""`},
+	}
+
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.ref)
+		if err != nil {
+			t.Errorf("json.Marshal(%+v) returned error: %v", tt.ref, err)
+		}
+
+		if string(b) != tt.expected {
+			t.Errorf("json.Marshal(%+v) = %s, expected %s", tt.ref, b, tt.expected)
+		}
+	}
+}
+
+func TestImageRef_roundTrip(t *testing.T) {
+	for _, ref := range []ImageRef{ImageRefFromSlug("ubuntu-14-04-x64"), ImageRefFromID(3240036)} {
+		in := &DropletCreateRequest{Name: "name", Image: ref}
+		b, _ := json.Marshal(in)
+
+		out := new(DropletCreateRequest)
+		if err := json.Unmarshal(b, out); err != nil {
+			t.Errorf("json.Unmarshal(%s) returned error: %v", b, err)
+		}
+
+		if !reflect.DeepEqual(out, in) {
+			t.Errorf("Round tripped %+v, got %+v", in, out)
+		}
+	}
+}
+
+func TestDroplet_unmarshalID(t *testing.T) {
+	droplet := new(Droplet)
+	if err := json.Unmarshal([]byte(`{"id":12345}`), droplet); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	if droplet.ID != 12345 {
+		t.Errorf("Droplet.ID = %v, expected %v", droplet.ID, 12345)
+	}
+
+	b, _ := json.Marshal(&Droplet{})
+	if strings.Contains(string(b), `"id"`) {
+		t.Errorf("json.Marshal(Droplet{}) = %s, expected id to be omitted", b)
+	}
+}
+
 func TestDroplet_String(t *testing.T) {
 
 	region := &Region{
@@ -184,8 +1231,99 @@ func TestDroplet_String(t *testing.T) {
 	}
 
 	stringified := droplet.String()
-	expected := `godo.Droplet{ID:1, Name:"droplet", Memory:123, Vcpus:456, Disk:789, Region:godo.Region{Slug:"region", Name:"Region", Sizes:["1" "2"], Available:true}, Image:godo.Image{ID:1, Name:"Image", Distribution:"Ubuntu", Slug:"image", Public:true, Regions:["one" "two"]}, Size:godo.Size{Slug:"size", Memory:0, Vcpus:0, Disk:0, PriceMonthly:123, PriceHourly:456, Regions:["1" "2"]}, BackupIDs:[1], SnapshotIDs:[1], Locked:false, Status:"active", Networks:godo.Networks{V4:[godo.Network{IPAddress:"192.168.1.2", Netmask:"255.255.255.0", Gateway:"192.168.1.1", Type:""}]}, ActionIDs:[1]}`
+	expected := `godo.Droplet{ID:1, Name:"droplet", Memory:123, Vcpus:456, Disk:789, Region:godo.Region{Slug:"region", Name:"Region", Sizes:["1" "2"], Available:true}, Image:godo.Image{ID:1, Name:"Image", Distribution:"Ubuntu", Slug:"image", Public:true, Regions:["one" "two"], Description:"", Status:""}, Size:godo.Size{Slug:"size", Memory:0, Vcpus:0, Disk:0, Transfer:0, PriceMonthly:123, PriceHourly:456, Regions:["1" "2"], Available:false}, SizeSlug:"", BackupIDs:[1], SnapshotIDs:[1], Locked:false, Status:"active", Networks:godo.Networks{V4:[godo.Network{IPAddress:"192.168.1.2", Netmask:"255.255.255.0", Gateway:"192.168.1.1", Type:""}]}, ActionIDs:[1]}`
 	if expected != stringified {
 		t.Errorf("Droplet.String returned %+v, expected %+v", stringified, expected)
 	}
 }
+
+func TestDroplet_units(t *testing.T) {
+	droplet := Droplet{Memory: 2048, Disk: 40}
+
+	if got, expected := droplet.MemoryMB(), 2048; got != expected {
+		t.Errorf("Droplet.MemoryMB() = %v, expected %v", got, expected)
+	}
+	if got, expected := droplet.MemoryGB(), 2.0; got != expected {
+		t.Errorf("Droplet.MemoryGB() = %v, expected %v", got, expected)
+	}
+	if got, expected := (Droplet{Memory: 512}).MemoryGB(), 0.5; got != expected {
+		t.Errorf("Droplet.MemoryGB() = %v, expected %v", got, expected)
+	}
+	if got, expected := droplet.DiskGB(), 40; got != expected {
+		t.Errorf("Droplet.DiskGB() = %v, expected %v", got, expected)
+	}
+}
+
+func TestDroplet_Features(t *testing.T) {
+	droplet := Droplet{Features: []string{"ipv6", "monitoring"}}
+
+	if !droplet.FeatureEnabled("ipv6") {
+		t.Errorf("Droplet.FeatureEnabled(%q) = false, expected true", "ipv6")
+	}
+	if droplet.FeatureEnabled("virtio") {
+		t.Errorf("Droplet.FeatureEnabled(%q) = true, expected false", "virtio")
+	}
+	if !droplet.HasIPv6() {
+		t.Errorf("Droplet.HasIPv6 = false, expected true")
+	}
+	if !droplet.HasMonitoring() {
+		t.Errorf("Droplet.HasMonitoring = false, expected true")
+	}
+	if droplet.HasPrivateNetworking() {
+		t.Errorf("Droplet.HasPrivateNetworking = true, expected false")
+	}
+}
+
+func TestDroplet_HasBackupsEnabled(t *testing.T) {
+	tests := []struct {
+		droplet  Droplet
+		expected bool
+	}{
+		{Droplet{Features: []string{"virtio", "backups"}}, true},
+		{Droplet{BackupIDs: []int{1}}, true},
+		{Droplet{Features: []string{"virtio"}}, false},
+		{Droplet{Features: []string{"virtio"}, BackupIDs: []int{1}}, false},
+		{Droplet{Features: []string{}, BackupIDs: []int{1}}, false},
+		{Droplet{}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.droplet.HasBackupsEnabled(); got != tt.expected {
+			t.Errorf("Droplet%+v.HasBackupsEnabled returned %v, expected %v", tt.droplet, got, tt.expected)
+		}
+	}
+}
+
+func TestDroplet_ResolveSizeSlug(t *testing.T) {
+	droplet := Droplet{Size: &Size{Slug: "1gb"}, SizeSlug: "512mb"}
+	if slug, err := droplet.ResolveSizeSlug(); err != nil || slug != "1gb" {
+		t.Errorf("Droplet.ResolveSizeSlug returned %v, %v, expected %v", slug, err, "1gb")
+	}
+
+	droplet = Droplet{SizeSlug: "512mb"}
+	if slug, err := droplet.ResolveSizeSlug(); err != nil || slug != "512mb" {
+		t.Errorf("Droplet.ResolveSizeSlug returned %v, %v, expected %v", slug, err, "512mb")
+	}
+
+	droplet = Droplet{Size: &Size{}}
+	if _, err := droplet.ResolveSizeSlug(); err == nil {
+		t.Errorf("Droplet.ResolveSizeSlug expected an error for a droplet without a size")
+	}
+}
+
+func TestDroplet_PriceMonthly_sizeSlugOnly(t *testing.T) {
+	sizes := []Size{
+		{Slug: "512mb", PriceMonthly: 5},
+		{Slug: "1gb", PriceMonthly: 10},
+	}
+
+	droplet := Droplet{SizeSlug: "1gb"}
+	price, err := droplet.PriceMonthly(sizes)
+	if err != nil {
+		t.Errorf("Droplet.PriceMonthly returned error: %v", err)
+	}
+
+	if expected := 10.0; price != expected {
+		t.Errorf("Droplet.PriceMonthly returned %v, expected %v", price, expected)
+	}
+}
@@ -0,0 +1,44 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+)
+
+// FloatingIPActionsService handles communication with the floating IP action
+// related methods of the DigitalOcean API.
+type FloatingIPActionsService struct {
+	client *Client
+}
+
+// Assign a floating IP to a droplet
+func (s *FloatingIPActionsService) Assign(ctx context.Context, ip string, dropletID int) (*Action, *Response, error) {
+	request := &ActionRequest{
+		Type:   "assign",
+		Params: map[string]interface{}{"droplet_id": dropletID},
+	}
+	return s.doAction(ctx, ip, request)
+}
+
+// Unassign a floating IP from the droplet it's assigned to
+func (s *FloatingIPActionsService) Unassign(ctx context.Context, ip string) (*Action, *Response, error) {
+	request := &ActionRequest{Type: "unassign"}
+	return s.doAction(ctx, ip, request)
+}
+
+func (s *FloatingIPActionsService) doAction(ctx context.Context, ip string, request *ActionRequest) (*Action, *Response, error) {
+	path := fmt.Sprintf("%s/%s/actions", floatingIPsBasePath, ip)
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &root.Event, resp, err
+}
@@ -0,0 +1,67 @@
+package godo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFloatingIPActions_Assign(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/floating_ips/192.168.0.1/actions", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		testMethod(t, r, "POST")
+		expected := `{"type":"assign","droplet_id":12345}`
+		if got := strings.TrimSpace(string(body)); got != expected {
+			t.Errorf("Request body = %v, expected %v", got, expected)
+		}
+
+		fmt.Fprint(w, `{"action":{"id":1,"status":"in-progress","type":"assign_ip"}}`)
+	})
+
+	action, _, err := client.FloatingIPActions.Assign(ctx, "192.168.0.1", 12345)
+	if err != nil {
+		t.Errorf("FloatingIPActions.Assign returned error: %v", err)
+	}
+
+	expected := &Action{ID: 1, Status: "in-progress", Type: "assign_ip"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("FloatingIPActions.Assign returned %+v, expected %+v", action, expected)
+	}
+}
+
+func TestFloatingIPActions_Unassign(t *testing.T) {
+	setup()
+	defer teardown()
+
+	unassignRequest := &ActionRequest{Type: "unassign"}
+
+	mux.HandleFunc("/v2/floating_ips/192.168.0.1/actions", func(w http.ResponseWriter, r *http.Request) {
+		v := new(ActionRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, unassignRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, unassignRequest)
+		}
+
+		fmt.Fprint(w, `{"action":{"id":2,"status":"completed","type":"unassign_ip"}}`)
+	})
+
+	action, _, err := client.FloatingIPActions.Unassign(ctx, "192.168.0.1")
+	if err != nil {
+		t.Errorf("FloatingIPActions.Unassign returned error: %v", err)
+	}
+
+	expected := &Action{ID: 2, Status: "completed", Type: "unassign_ip"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("FloatingIPActions.Unassign returned %+v, expected %+v", action, expected)
+	}
+}
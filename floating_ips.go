@@ -0,0 +1,115 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+)
+
+const floatingIPsBasePath = "v2/floating_ips"
+
+// FloatingIPsService handles communication with the floating IP related
+// methods of the DigitalOcean API.
+type FloatingIPsService struct {
+	client *Client
+}
+
+// FloatingIP represents a DigitalOcean floating IP. Droplet is nil if the IP
+// isn't assigned to a droplet.
+type FloatingIP struct {
+	IP      string   `json:"ip,omitempty"`
+	Region  *Region  `json:"region,omitempty"`
+	Droplet *Droplet `json:"droplet,omitempty"`
+}
+
+func (f FloatingIP) String() string {
+	return Stringify(f)
+}
+
+// FloatingIPCreateRequest represents a request to create a floating IP. Set
+// DropletID to assign the IP to a droplet as it's created, or Region to
+// reserve it in a region without assigning it.
+type FloatingIPCreateRequest struct {
+	DropletID int    `json:"droplet_id,omitempty"`
+	Region    string `json:"region,omitempty"`
+}
+
+func (f FloatingIPCreateRequest) String() string {
+	return Stringify(f)
+}
+
+type floatingIPRoot struct {
+	FloatingIP FloatingIP `json:"floating_ip"`
+}
+
+type floatingIPsRoot struct {
+	FloatingIPs []FloatingIP `json:"floating_ips"`
+}
+
+// List floating IPs, one page at a time. A nil opt requests the first page.
+func (s *FloatingIPsService) List(ctx context.Context, opt *ListOptions) ([]FloatingIP, *Response, error) {
+	path, err := addOptions(floatingIPsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(floatingIPsRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.FloatingIPs, resp, err
+}
+
+// Get a floating IP by its address
+func (s *FloatingIPsService) Get(ctx context.Context, ip string) (*FloatingIP, *Response, error) {
+	path := fmt.Sprintf("%s/%s", floatingIPsBasePath, ip)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(floatingIPRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &root.FloatingIP, resp, err
+}
+
+// Create a floating IP using a FloatingIPCreateRequest
+func (s *FloatingIPsService) Create(ctx context.Context, createRequest *FloatingIPCreateRequest) (*FloatingIP, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", floatingIPsBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(floatingIPRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &root.FloatingIP, resp, err
+}
+
+// Delete a floating IP by its address
+func (s *FloatingIPsService) Delete(ctx context.Context, ip string) (*Response, error) {
+	path := fmt.Sprintf("%s/%s", floatingIPsBasePath, ip)
+
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+
+	return resp, err
+}
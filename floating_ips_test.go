@@ -0,0 +1,121 @@
+package godo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestFloatingIPs_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/floating_ips", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"floating_ips":[{"ip":"192.168.0.1","region":{"slug":"nyc3"}},`+
+			`{"ip":"192.168.0.2","region":{"slug":"nyc3"},"droplet":{"id":1}}]}`)
+	})
+
+	ips, _, err := client.FloatingIPs.List(ctx, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("FloatingIPs.List returned error: %v", err)
+	}
+
+	expected := []FloatingIP{
+		{IP: "192.168.0.1", Region: &Region{Slug: "nyc3"}},
+		{IP: "192.168.0.2", Region: &Region{Slug: "nyc3"}, Droplet: &Droplet{ID: 1}},
+	}
+	if !reflect.DeepEqual(ips, expected) {
+		t.Errorf("FloatingIPs.List returned %+v, expected %+v", ips, expected)
+	}
+}
+
+func TestFloatingIPs_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/floating_ips/192.168.0.1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"floating_ip":{"ip":"192.168.0.1","region":{"slug":"nyc3"}}}`)
+	})
+
+	ip, _, err := client.FloatingIPs.Get(ctx, "192.168.0.1")
+	if err != nil {
+		t.Errorf("FloatingIPs.Get returned error: %v", err)
+	}
+
+	expected := &FloatingIP{IP: "192.168.0.1", Region: &Region{Slug: "nyc3"}}
+	if !reflect.DeepEqual(ip, expected) {
+		t.Errorf("FloatingIPs.Get returned %+v, expected %+v", ip, expected)
+	}
+}
+
+func TestFloatingIPs_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		createRequest *FloatingIPCreateRequest
+		body          string
+		response      string
+		expected      *FloatingIP
+	}{
+		{
+			name:          "assign to droplet",
+			createRequest: &FloatingIPCreateRequest{DropletID: 12345},
+			body:          `{"droplet_id":12345}`,
+			response:      `{"floating_ip":{"ip":"192.168.0.1","region":{"slug":"nyc3"},"droplet":{"id":12345}}}`,
+			expected:      &FloatingIP{IP: "192.168.0.1", Region: &Region{Slug: "nyc3"}, Droplet: &Droplet{ID: 12345}},
+		},
+		{
+			name:          "reserve in region",
+			createRequest: &FloatingIPCreateRequest{Region: "nyc3"},
+			body:          `{"region":"nyc3"}`,
+			response:      `{"floating_ip":{"ip":"192.168.0.2","region":{"slug":"nyc3"}}}`,
+			expected:      &FloatingIP{IP: "192.168.0.2", Region: &Region{Slug: "nyc3"}},
+		},
+	}
+
+	for _, tt := range tests {
+		setup()
+
+		mux.HandleFunc("/v2/floating_ips", func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+
+			var v, expected map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&v)
+			json.Unmarshal([]byte(tt.body), &expected)
+			if !reflect.DeepEqual(v, expected) {
+				t.Errorf("%s: Request body = %+v, expected %+v", tt.name, v, expected)
+			}
+
+			fmt.Fprint(w, tt.response)
+		})
+
+		ip, _, err := client.FloatingIPs.Create(ctx, tt.createRequest)
+		if err != nil {
+			t.Errorf("%s: FloatingIPs.Create returned error: %v", tt.name, err)
+		}
+
+		if !reflect.DeepEqual(ip, tt.expected) {
+			t.Errorf("%s: FloatingIPs.Create returned %+v, expected %+v", tt.name, ip, tt.expected)
+		}
+
+		teardown()
+	}
+}
+
+func TestFloatingIPs_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/floating_ips/192.168.0.1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.FloatingIPs.Delete(ctx, "192.168.0.1")
+	if err != nil {
+		t.Errorf("FloatingIPs.Delete returned error: %v", err)
+	}
+}
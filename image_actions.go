@@ -1,6 +1,10 @@
 package godo
 
-import "fmt"
+import (
+	"context"
+	"errors"
+	"fmt"
+)
 
 // ImageActionsService handles communition with the image action related methods of the
 // DigitalOcean API.
@@ -8,11 +12,29 @@ type ImageActionsService struct {
 	client *Client
 }
 
-// Transfer an image
-func (i *ImageActionsService) Transfer(imageID int, transferRequest *ActionRequest) (*Action, *Response, error) {
+// Transfer an image to the region with the given slug
+func (i *ImageActionsService) Transfer(ctx context.Context, imageID int, region string) (*Action, *Response, error) {
+	if region == "" {
+		return nil, nil, errors.New("region must not be empty")
+	}
+
+	request := &ActionRequest{
+		Type:   "transfer",
+		Params: map[string]interface{}{"region": region},
+	}
+	return i.doAction(ctx, imageID, request)
+}
+
+// Convert an image, such as a snapshot, to a backup
+func (i *ImageActionsService) Convert(ctx context.Context, imageID int) (*Action, *Response, error) {
+	request := &ActionRequest{Type: "convert"}
+	return i.doAction(ctx, imageID, request)
+}
+
+func (i *ImageActionsService) doAction(ctx context.Context, imageID int, request *ActionRequest) (*Action, *Response, error) {
 	path := fmt.Sprintf("v2/images/%d/actions", imageID)
 
-	req, err := i.client.NewRequest("POST", path, transferRequest)
+	req, err := i.client.NewRequestWithContext(ctx, "POST", path, request)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -26,11 +48,33 @@ func (i *ImageActionsService) Transfer(imageID int, transferRequest *ActionReque
 	return &root.Event, resp, err
 }
 
+// List the action history of an image
+func (i *ImageActionsService) List(ctx context.Context, imageID int, opt *ListOptions) ([]Action, *Response, error) {
+	path := fmt.Sprintf("v2/images/%d/actions", imageID)
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := i.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionsRoot)
+	resp, err := i.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Actions, resp, err
+}
+
 // Get an action for a particular image by id.
-func (i *ImageActionsService) Get(imageID, actionID int) (*Action, *Response, error) {
+func (i *ImageActionsService) Get(ctx context.Context, imageID, actionID int) (*Action, *Response, error) {
 	path := fmt.Sprintf("v2/images/%d/actions/%d", imageID, actionID)
 
-	req, err := i.client.NewRequest("GET", path, nil)
+	req, err := i.client.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
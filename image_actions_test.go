@@ -3,8 +3,10 @@ package godo
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -12,29 +14,90 @@ func TestImageActions_Transfer(t *testing.T) {
 	setup()
 	defer teardown()
 
-	transferRequest := &ActionRequest{}
+	mux.HandleFunc("/v2/images/12345/actions", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		testMethod(t, r, "POST")
+		expected := `{"type":"transfer","region":"nyc2"}`
+		if got := strings.TrimSpace(string(body)); got != expected {
+			t.Errorf("Request body = %v, expected %v", got, expected)
+		}
+
+		fmt.Fprintf(w, `{"action":{"id":1,"status":"in-progress","type":"transfer"}}`)
+	})
+
+	transfer, _, err := client.ImageActions.Transfer(ctx, 12345, "nyc2")
+	if err != nil {
+		t.Errorf("ImageActions.Transfer returned error: %v", err)
+	}
+
+	expected := &Action{ID: 1, Status: "in-progress", Type: "transfer"}
+	if !reflect.DeepEqual(transfer, expected) {
+		t.Errorf("ImageActions.Transfer returned %+v, expected %+v", transfer, expected)
+	}
+}
+
+func TestImageActions_Transfer_emptyRegion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/images/12345/actions", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("ImageActions.Transfer sent a request without a region")
+	})
+
+	_, _, err := client.ImageActions.Transfer(ctx, 12345, "")
+	if err == nil {
+		t.Error("Expected error to be returned.")
+	}
+}
+
+func TestImageActions_Convert(t *testing.T) {
+	setup()
+	defer teardown()
+
+	convertRequest := &ActionRequest{Type: "convert"}
 
 	mux.HandleFunc("/v2/images/12345/actions", func(w http.ResponseWriter, r *http.Request) {
 		v := new(ActionRequest)
 		json.NewDecoder(r.Body).Decode(v)
 
 		testMethod(t, r, "POST")
-		if !reflect.DeepEqual(v, transferRequest) {
-			t.Errorf("Request body = %+v, expected %+v", v, transferRequest)
+		if !reflect.DeepEqual(v, convertRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, convertRequest)
 		}
 
-		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
+		fmt.Fprintf(w, `{"action":{"id":1,"status":"in-progress","type":"convert"}}`)
+	})
+
+	action, _, err := client.ImageActions.Convert(ctx, 12345)
+	if err != nil {
+		t.Errorf("ImageActions.Convert returned error: %v", err)
+	}
+
+	expected := &Action{ID: 1, Status: "in-progress", Type: "convert"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("ImageActions.Convert returned %+v, expected %+v", action, expected)
+	}
+}
+
+func TestImageActions_List(t *testing.T) {
+	setup()
+	defer teardown()
 
+	mux.HandleFunc("/v2/images/123/actions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprintf(w, `{"actions":[{"id":1,"type":"transfer"},{"id":2,"type":"convert"}]}`)
 	})
 
-	transfer, _, err := client.ImageActions.Transfer(12345, transferRequest)
+	actions, _, err := client.ImageActions.List(ctx, 123, &ListOptions{Page: 2})
 	if err != nil {
-		t.Errorf("ImageActions.Transfer returned error: %v", err)
+		t.Errorf("ImageActions.List returned error: %v", err)
 	}
 
-	expected := &Action{Status: "in-progress"}
-	if !reflect.DeepEqual(transfer, expected) {
-		t.Errorf("ImageActions.Transfer returned %+v, expected %+v", transfer, expected)
+	expected := []Action{{ID: 1, Type: "transfer"}, {ID: 2, Type: "convert"}}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Errorf("ImageActions.List returned %+v, expected %+v", actions, expected)
 	}
 }
 
@@ -47,7 +110,7 @@ func TestImageActions_Get(t *testing.T) {
 		fmt.Fprintf(w, `{"action":{"status":"in-progress"}}`)
 	})
 
-	action, _, err := client.ImageActions.Get(123, 456)
+	action, _, err := client.ImageActions.Get(ctx, 123, 456)
 	if err != nil {
 		t.Errorf("ImageActions.Get returned error: %v", err)
 	}
@@ -1,5 +1,13 @@
 package godo
 
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+const imagesBasePath = "v2/images"
+
 // ImagesService handles communication with the image related methods of the
 // DigitalOcean API.
 type ImagesService struct {
@@ -8,31 +16,109 @@ type ImagesService struct {
 
 // Image represents a DigitalOcean Image
 type Image struct {
-	ID           int      `json:"id,float64,omitempty"`
-	Name         string   `json:"name,omitempty"`
+	ID           int        `json:"id,omitempty"`
+	Name         string     `json:"name,omitempty"`
+	Distribution string     `json:"distribution,omitempty"`
+	Slug         string     `json:"slug,omitempty"`
+	Public       bool       `json:"public,omitempty"`
+	Regions      []string   `json:"regions,omitempty"`
+	Tags         []string   `json:"tags,omitempty"`
+	CreatedAt    *Timestamp `json:"created_at,omitempty"`
+	Description  string     `json:"description,omitempty"`
+	Status       string     `json:"status,omitempty"`
+}
+
+// CustomImageCreateRequest represents a request to import a custom image
+// from a URL.
+type CustomImageCreateRequest struct {
+	Name         string   `json:"name"`
+	URL          string   `json:"url"`
+	Region       string   `json:"region"`
 	Distribution string   `json:"distribution,omitempty"`
-	Slug         string   `json:"slug,omitempty"`
-	Public       bool     `json:"public,omitempty"`
-	Regions      []string `json:"regions,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+}
+
+func (c CustomImageCreateRequest) String() string {
+	return Stringify(c)
 }
 
 type imageRoot struct {
-	Image Image
+	Image Image `json:"image"`
 }
 
 type imagesRoot struct {
-	Images []Image
+	Images []Image `json:"images"`
 }
 
 func (i Image) String() string {
 	return Stringify(i)
 }
 
-// List all sizes
-func (s *ImagesService) List() ([]Image, *Response, error) {
-	path := "v2/images"
+// List all images
+func (s *ImagesService) List(ctx context.Context) ([]Image, *Response, error) {
+	return s.list(ctx, imagesBasePath)
+}
+
+// ListDistribution lists all distribution images
+func (s *ImagesService) ListDistribution(ctx context.Context) ([]Image, *Response, error) {
+	path := fmt.Sprintf("%s?type=distribution", imagesBasePath)
+	return s.list(ctx, path)
+}
+
+// ListByTag lists all images tagged with tag
+func (s *ImagesService) ListByTag(ctx context.Context, tag string) ([]Image, *Response, error) {
+	path := fmt.Sprintf("%s?tag_name=%s", imagesBasePath, url.QueryEscape(tag))
+	return s.list(ctx, path)
+}
+
+// Get an image by id
+func (s *ImagesService) Get(ctx context.Context, imageID int) (*Image, *Response, error) {
+	path := fmt.Sprintf("%s/%d", imagesBasePath, imageID)
+	return s.get(ctx, path)
+}
+
+// GetBySlug gets an image by its slug, such as "ubuntu-14-04-x64"
+func (s *ImagesService) GetBySlug(ctx context.Context, slug string) (*Image, *Response, error) {
+	path := fmt.Sprintf("%s/%s", imagesBasePath, url.PathEscape(slug))
+	return s.get(ctx, path)
+}
+
+// CreateCustom imports a custom image from a URL. The API does not return an
+// action for the import; its progress is reported by the returned image's
+// Status, which is "NEW" until the import completes.
+func (s *ImagesService) CreateCustom(ctx context.Context, createRequest *CustomImageCreateRequest) (*Image, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", imagesBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(imageRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &root.Image, resp, err
+}
+
+func (s *ImagesService) get(ctx context.Context, path string) (*Image, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(imageRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &root.Image, resp, err
+}
 
-	req, err := s.client.NewRequest("GET", path, nil)
+func (s *ImagesService) list(ctx context.Context, path string) ([]Image, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
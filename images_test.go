@@ -1,6 +1,7 @@
 package godo
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -16,7 +17,7 @@ func TestImages_List(t *testing.T) {
 		fmt.Fprint(w, `{"images":[{"id":1},{"id":2}]}`)
 	})
 
-	images, _, err := client.Images.List()
+	images, _, err := client.Images.List(ctx)
 	if err != nil {
 		t.Errorf("Images.List returned error: %v", err)
 	}
@@ -27,6 +28,145 @@ func TestImages_List(t *testing.T) {
 	}
 }
 
+func TestImages_ListByTag(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/images", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"tag_name": "db-backup"})
+		fmt.Fprint(w, `{"images":[{"id":1,"name":"db snapshot","tags":["db-backup"]}]}`)
+	})
+
+	images, _, err := client.Images.ListByTag(ctx, "db-backup")
+	if err != nil {
+		t.Errorf("Images.ListByTag returned error: %v", err)
+	}
+
+	expected := []Image{{ID: 1, Name: "db snapshot", Tags: []string{"db-backup"}}}
+	if !reflect.DeepEqual(images, expected) {
+		t.Errorf("Images.ListByTag returned %+v, expected %+v", images, expected)
+	}
+}
+
+func TestImages_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/images/6918990", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"image":{"id":6918990,"name":"14.04 x64","distribution":"Ubuntu",`+
+			`"slug":"ubuntu-14-04-x64","public":true,"regions":["nyc1","ams1"]}}`)
+	})
+
+	image, _, err := client.Images.Get(ctx, 6918990)
+	if err != nil {
+		t.Errorf("Images.Get returned error: %v", err)
+	}
+
+	expected := &Image{
+		ID:           6918990,
+		Name:         "14.04 x64",
+		Distribution: "Ubuntu",
+		Slug:         "ubuntu-14-04-x64",
+		Public:       true,
+		Regions:      []string{"nyc1", "ams1"},
+	}
+	if !reflect.DeepEqual(image, expected) {
+		t.Errorf("Images.Get returned %+v, expected %+v", image, expected)
+	}
+}
+
+func TestImages_GetBySlug(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/images/ubuntu-14-04-x64", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"image":{"id":6918990,"slug":"ubuntu-14-04-x64"}}`)
+	})
+
+	image, _, err := client.Images.GetBySlug(ctx, "ubuntu-14-04-x64")
+	if err != nil {
+		t.Errorf("Images.GetBySlug returned error: %v", err)
+	}
+
+	expected := &Image{ID: 6918990, Slug: "ubuntu-14-04-x64"}
+	if !reflect.DeepEqual(image, expected) {
+		t.Errorf("Images.GetBySlug returned %+v, expected %+v", image, expected)
+	}
+}
+
+func TestImages_rootTags(t *testing.T) {
+	b, _ := json.Marshal(imageRoot{Image: Image{ID: 1}})
+	if expected := `{"image":{"id":1}}`; string(b) != expected {
+		t.Errorf("json.Marshal(imageRoot) = %s, expected %s", b, expected)
+	}
+
+	b, _ = json.Marshal(imagesRoot{Images: []Image{{ID: 1}}})
+	if expected := `{"images":[{"id":1}]}`; string(b) != expected {
+		t.Errorf("json.Marshal(imagesRoot) = %s, expected %s", b, expected)
+	}
+}
+
+func TestImages_CreateCustom(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &CustomImageCreateRequest{
+		Name:         "ubuntu-18.04-minimal",
+		URL:          "http://cloud-images.ubuntu.com/minimal/releases/bionic/release/ubuntu-18.04-minimal-cloudimg-amd64.img",
+		Region:       "nyc3",
+		Distribution: "Ubuntu",
+		Description:  "Cloud-optimized image w/ small footprint",
+		Tags:         []string{"base-image", "prod"},
+	}
+
+	mux.HandleFunc("/v2/images", func(w http.ResponseWriter, r *http.Request) {
+		v := new(CustomImageCreateRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, createRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, createRequest)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"image":{"id":38413969,"name":"ubuntu-18.04-minimal","distribution":"Ubuntu",`+
+			`"regions":[],"tags":["base-image","prod"],"status":"NEW",`+
+			`"description":"Cloud-optimized image w/ small footprint"}}`)
+	})
+
+	image, _, err := client.Images.CreateCustom(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Images.CreateCustom returned error: %v", err)
+	}
+
+	expected := &Image{
+		ID:           38413969,
+		Name:         "ubuntu-18.04-minimal",
+		Distribution: "Ubuntu",
+		Regions:      []string{},
+		Tags:         []string{"base-image", "prod"},
+		Status:       "NEW",
+		Description:  "Cloud-optimized image w/ small footprint",
+	}
+	if !reflect.DeepEqual(image, expected) {
+		t.Errorf("Images.CreateCustom returned %+v, expected %+v", image, expected)
+	}
+}
+
+func TestImage_unmarshalID(t *testing.T) {
+	image := new(Image)
+	if err := json.Unmarshal([]byte(`{"id":12345}`), image); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+
+	if image.ID != 12345 {
+		t.Errorf("Image.ID = %v, expected %v", image.ID, 12345)
+	}
+}
+
 func TestImage_String(t *testing.T) {
 	image := &Image{
 		ID:           1,
@@ -38,7 +178,7 @@ func TestImage_String(t *testing.T) {
 	}
 
 	stringified := image.String()
-	expected := `godo.Image{ID:1, Name:"Image", Distribution:"Ubuntu", Slug:"image", Public:true, Regions:["one" "two"]}`
+	expected := `godo.Image{ID:1, Name:"Image", Distribution:"Ubuntu", Slug:"image", Public:true, Regions:["one" "two"], Description:"", Status:""}`
 	if expected != stringified {
 		t.Errorf("Image.String returned %+v, expected %+v", stringified, expected)
 	}
@@ -1,6 +1,14 @@
 package godo
 
-import "fmt"
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
 
 const keysBasePath = "v2/account/keys"
 
@@ -12,10 +20,11 @@ type KeysService struct {
 
 // Key represents a DigitalOcean Key.
 type Key struct {
-	ID          int    `json:"id,float64,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Fingerprint string `json:"fingerprint,omitempty"`
-	PublicKey   string `json:"public_key,omitempty"`
+	ID          int        `json:"id,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Fingerprint string     `json:"fingerprint,omitempty"`
+	PublicKey   string     `json:"public_key,omitempty"`
+	CreatedAt   *Timestamp `json:"created_at,omitempty"`
 }
 
 type keysRoot struct {
@@ -30,6 +39,16 @@ func (s Key) String() string {
 	return Stringify(s)
 }
 
+// Age returns how long ago the key was created. It returns 0 if the API did
+// not report when the key was created.
+func (s Key) Age() time.Duration {
+	if s.CreatedAt == nil {
+		return 0
+	}
+
+	return time.Since(s.CreatedAt.Time)
+}
+
 // KeyCreateRequest represents a request to create a new key.
 type KeyCreateRequest struct {
 	Name      string `json:"name"`
@@ -37,8 +56,28 @@ type KeyCreateRequest struct {
 }
 
 // List all keys
-func (s *KeysService) List() ([]Key, *Response, error) {
-	req, err := s.client.NewRequest("GET", keysBasePath, nil)
+func (s *KeysService) List(ctx context.Context) ([]Key, *Response, error) {
+	return s.list(ctx, keysBasePath)
+}
+
+// ListAll lists every key, following the next page links until the last page.
+// The Response returned is that of the last page requested.
+func (s *KeysService) ListAll(ctx context.Context) ([]Key, *Response, error) {
+	var all []Key
+	resp, err := listPages(keysBasePath, func(path string) (*Response, bool, error) {
+		keys, resp, err := s.list(ctx, path)
+		all = append(all, keys...)
+		return resp, false, err
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return all, resp, nil
+}
+
+func (s *KeysService) list(ctx context.Context, path string) ([]Key, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -53,8 +92,8 @@ func (s *KeysService) List() ([]Key, *Response, error) {
 }
 
 // Performs a get given a path
-func (s *KeysService) get(path string) (*Key, *Response, error) {
-	req, err := s.client.NewRequest("GET", path, nil)
+func (s *KeysService) get(ctx context.Context, path string) (*Key, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -69,20 +108,20 @@ func (s *KeysService) get(path string) (*Key, *Response, error) {
 }
 
 // GetByID gets a Key by id
-func (s *KeysService) GetByID(keyID int) (*Key, *Response, error) {
+func (s *KeysService) GetByID(ctx context.Context, keyID int) (*Key, *Response, error) {
 	path := fmt.Sprintf("%s/%d", keysBasePath, keyID)
-	return s.get(path)
+	return s.get(ctx, path)
 }
 
 // GetByFingerprint gets a Key by by fingerprint
-func (s *KeysService) GetByFingerprint(fingerprint string) (*Key, *Response, error) {
+func (s *KeysService) GetByFingerprint(ctx context.Context, fingerprint string) (*Key, *Response, error) {
 	path := fmt.Sprintf("%s/%s", keysBasePath, fingerprint)
-	return s.get(path)
+	return s.get(ctx, path)
 }
 
 // Create a key using a KeyCreateRequest
-func (s *KeysService) Create(createRequest *KeyCreateRequest) (*Key, *Response, error) {
-	req, err := s.client.NewRequest("POST", keysBasePath, createRequest)
+func (s *KeysService) Create(ctx context.Context, createRequest *KeyCreateRequest) (*Key, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", keysBasePath, createRequest)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -97,8 +136,8 @@ func (s *KeysService) Create(createRequest *KeyCreateRequest) (*Key, *Response,
 }
 
 // Delete key using a path
-func (s *KeysService) delete(path string) (*Response, error) {
-	req, err := s.client.NewRequest("DELETE", path, nil)
+func (s *KeysService) delete(ctx context.Context, path string) (*Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", path, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -109,13 +148,88 @@ func (s *KeysService) delete(path string) (*Response, error) {
 }
 
 // DeleteByID deletes a key by its id
-func (s *KeysService) DeleteByID(keyID int) (*Response, error) {
+func (s *KeysService) DeleteByID(ctx context.Context, keyID int) (*Response, error) {
 	path := fmt.Sprintf("%s/%d", keysBasePath, keyID)
-	return s.delete(path)
+	return s.delete(ctx, path)
 }
 
 // DeleteByFingerprint deletes a key by its fingerprint
-func (s *KeysService) DeleteByFingerprint(fingerprint string) (*Response, error) {
+func (s *KeysService) DeleteByFingerprint(ctx context.Context, fingerprint string) (*Response, error) {
 	path := fmt.Sprintf("%s/%s", keysBasePath, fingerprint)
-	return s.delete(path)
+	return s.delete(ctx, path)
+}
+
+// ImportFromAuthorizedKeys creates a key for each public key listed in the
+// authorized_keys file at path. The comment following a key is used as its
+// name. Keys that fail to parse or create are skipped; their errors are
+// aggregated into the returned error alongside the keys that were created.
+func (s *KeysService) ImportFromAuthorizedKeys(ctx context.Context, path string) ([]*Key, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var keys []*Key
+	var errs []error
+
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		createRequest, err := parseAuthorizedKey(line)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s:%d: %v", path, lineNo, err))
+			continue
+		}
+
+		if createRequest.Name == "" {
+			createRequest.Name = fmt.Sprintf("%s:%d", path, lineNo)
+		}
+
+		key, _, err := s.Create(ctx, createRequest)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s:%d: %v", path, lineNo, err))
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	if err := scanner.Err(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return keys, errors.Join(errs...)
+}
+
+// parseAuthorizedKey parses a single authorized_keys line of the form
+// "[options] type base64-key [comment]".
+func parseAuthorizedKey(line string) (*KeyCreateRequest, error) {
+	fields := strings.Fields(line)
+	for i, field := range fields {
+		if !isAuthorizedKeyType(field) {
+			continue
+		}
+
+		if i+1 >= len(fields) {
+			return nil, fmt.Errorf("missing key data for type %s", field)
+		}
+
+		return &KeyCreateRequest{
+			Name:      strings.Join(fields[i+2:], " "),
+			PublicKey: field + " " + fields[i+1],
+		}, nil
+	}
+
+	return nil, errors.New("no public key found")
+}
+
+func isAuthorizedKeyType(field string) bool {
+	return strings.HasPrefix(field, "ssh-") ||
+		strings.HasPrefix(field, "ecdsa-sha2-") ||
+		strings.HasPrefix(field, "sk-")
 }
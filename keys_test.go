@@ -3,9 +3,13 @@ package godo
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestKeys_List(t *testing.T) {
@@ -17,7 +21,7 @@ func TestKeys_List(t *testing.T) {
 		fmt.Fprint(w, `{"ssh_keys":[{"id":1},{"id":2}]}   `)
 	})
 
-	keys, _, err := client.Keys.List()
+	keys, _, err := client.Keys.List(ctx)
 	if err != nil {
 		t.Errorf("Keys.List returned error: %v", err)
 	}
@@ -28,6 +32,31 @@ func TestKeys_List(t *testing.T) {
 	}
 }
 
+func TestKeys_ListAll(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"ssh_keys":[{"id":3}]}`)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/v2/account/keys?page=2>; rel="next"`, server.URL))
+		fmt.Fprint(w, `{"ssh_keys":[{"id":1},{"id":2}]}`)
+	})
+
+	keys, _, err := client.Keys.ListAll(ctx)
+	if err != nil {
+		t.Errorf("Keys.ListAll returned error: %v", err)
+	}
+
+	expected := []Key{{ID: 1}, {ID: 2}, {ID: 3}}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Keys.ListAll returned %+v, expected %+v", keys, expected)
+	}
+}
+
 func TestKeys_GetByID(t *testing.T) {
 	setup()
 	defer teardown()
@@ -37,7 +66,7 @@ func TestKeys_GetByID(t *testing.T) {
 		fmt.Fprint(w, `{"ssh_key": {"id":12345}}`)
 	})
 
-	keys, _, err := client.Keys.GetByID(12345)
+	keys, _, err := client.Keys.GetByID(ctx, 12345)
 	if err != nil {
 		t.Errorf("Keys.GetByID returned error: %v", err)
 	}
@@ -48,6 +77,36 @@ func TestKeys_GetByID(t *testing.T) {
 	}
 }
 
+func TestKeys_GetByID_createdAt(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/account/keys/12345", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ssh_key": {"id":12345,"created_at":"2014-05-08T20:36:47Z"}}`)
+	})
+
+	key, _, err := client.Keys.GetByID(ctx, 12345)
+	if err != nil {
+		t.Errorf("Keys.GetByID returned error: %v", err)
+	}
+
+	expected := time.Date(2014, 5, 8, 20, 36, 47, 0, time.UTC)
+	if key.CreatedAt == nil || !key.CreatedAt.Time.Equal(expected) {
+		t.Fatalf("Key.CreatedAt = %v, expected %v", key.CreatedAt, expected)
+	}
+
+	if age := key.Age(); age < time.Since(expected)-time.Minute {
+		t.Errorf("Key.Age() = %v, expected about %v", age, time.Since(expected))
+	}
+}
+
+func TestKey_Age_noCreatedAt(t *testing.T) {
+	key := Key{ID: 12345}
+	if age := key.Age(); age != 0 {
+		t.Errorf("Key.Age() = %v, expected 0", age)
+	}
+}
+
 func TestKeys_GetByFingerprint(t *testing.T) {
 	setup()
 	defer teardown()
@@ -57,7 +116,7 @@ func TestKeys_GetByFingerprint(t *testing.T) {
 		fmt.Fprint(w, `{"ssh_key": {"fingerprint":"aa:bb:cc"}}`)
 	})
 
-	keys, _, err := client.Keys.GetByFingerprint("aa:bb:cc")
+	keys, _, err := client.Keys.GetByFingerprint(ctx, "aa:bb:cc")
 	if err != nil {
 		t.Errorf("Keys.GetByFingerprint returned error: %v", err)
 	}
@@ -89,7 +148,7 @@ func TestKeys_Create(t *testing.T) {
 		fmt.Fprintf(w, `{"ssh_key":{"id":1}}`)
 	})
 
-	key, _, err := client.Keys.Create(createRequest)
+	key, _, err := client.Keys.Create(ctx, createRequest)
 	if err != nil {
 		t.Errorf("Keys.Create returned error: %v", err)
 	}
@@ -108,7 +167,7 @@ func TestKeys_DestroyByID(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Keys.DeleteByID(12345)
+	_, err := client.Keys.DeleteByID(ctx, 12345)
 	if err != nil {
 		t.Errorf("Keys.Delete returned error: %v", err)
 	}
@@ -122,12 +181,99 @@ func TestKeys_DestroyByFingerprint(t *testing.T) {
 		testMethod(t, r, "DELETE")
 	})
 
-	_, err := client.Keys.DeleteByFingerprint("aa:bb:cc")
+	_, err := client.Keys.DeleteByFingerprint(ctx, "aa:bb:cc")
 	if err != nil {
 		t.Errorf("Keys.Delete returned error: %v", err)
 	}
 }
 
+func TestKeys_ImportFromAuthorizedKeys(t *testing.T) {
+	setup()
+	defer teardown()
+
+	authorizedKeys := "# team keys\n" +
+		"ssh-rsa AAAAB3NzaC1yc2E alice@example.com\n" +
+		"\n" +
+		`no-port-forwarding,command="echo hi" ssh-ed25519 AAAAC3NzaC1lZDI1NTE5 bob laptop` + "\n"
+
+	path := filepath.Join(t.TempDir(), "authorized_keys")
+	if err := ioutil.WriteFile(path, []byte(authorizedKeys), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var requests []KeyCreateRequest
+	mux.HandleFunc("/v2/account/keys", func(w http.ResponseWriter, r *http.Request) {
+		v := new(KeyCreateRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		requests = append(requests, *v)
+
+		fmt.Fprintf(w, `{"ssh_key":{"id":%d,"name":"%s"}}`, len(requests), v.Name)
+	})
+
+	keys, err := client.Keys.ImportFromAuthorizedKeys(ctx, path)
+	if err != nil {
+		t.Errorf("Keys.ImportFromAuthorizedKeys returned error: %v", err)
+	}
+
+	expectedRequests := []KeyCreateRequest{
+		{Name: "alice@example.com", PublicKey: "ssh-rsa AAAAB3NzaC1yc2E"},
+		{Name: "bob laptop", PublicKey: "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5"},
+	}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("Request bodies = %+v, expected %+v", requests, expectedRequests)
+	}
+
+	expected := []*Key{{ID: 1, Name: "alice@example.com"}, {ID: 2, Name: "bob laptop"}}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Keys.ImportFromAuthorizedKeys returned %+v, expected %+v", keys, expected)
+	}
+}
+
+func TestKeys_ImportFromAuthorizedKeys_errors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	authorizedKeys := "ssh-rsa AAAAB3NzaC1yc2E alice\n" +
+		"not a key\n" +
+		"ssh-rsa AAAAB3NzaC1yc2F duplicate\n"
+
+	path := filepath.Join(t.TempDir(), "authorized_keys")
+	if err := ioutil.WriteFile(path, []byte(authorizedKeys), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	mux.HandleFunc("/v2/account/keys", func(w http.ResponseWriter, r *http.Request) {
+		v := new(KeyCreateRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		if v.Name == "duplicate" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprint(w, `{"message":"SSH Key is already in use on your account"}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"ssh_key":{"id":1}}`)
+	})
+
+	keys, err := client.Keys.ImportFromAuthorizedKeys(ctx, path)
+	if err == nil {
+		t.Fatal("Keys.ImportFromAuthorizedKeys expected an error")
+	}
+
+	for _, line := range []string{":2:", ":3:"} {
+		if !strings.Contains(err.Error(), line) {
+			t.Errorf("Keys.ImportFromAuthorizedKeys error %q does not mention line %q", err, line)
+		}
+	}
+
+	expected := []*Key{{ID: 1}}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Keys.ImportFromAuthorizedKeys returned %+v, expected %+v", keys, expected)
+	}
+}
+
 func TestKey_String(t *testing.T) {
 	key := &Key{
 		ID:          123,
@@ -0,0 +1,115 @@
+package godo
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strconv"
+)
+
+// ErrInvalidPaginationToken is returned when a pagination token can't be
+// decoded.
+var ErrInvalidPaginationToken = errors.New("godo: invalid pagination token")
+
+// PaginationState is a position in a paginated result set. It marshals to an
+// opaque token that can be stored, for instance by a sync job that may be
+// restarted, and later unmarshalled to resume listing from the same page.
+type PaginationState struct {
+	// Page to retrieve next
+	Page int
+
+	// Number of results per page, or zero for the API's default
+	PerPage int
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (s PaginationState) MarshalText() ([]byte, error) {
+	v := url.Values{}
+	v.Set("page", strconv.Itoa(s.Page))
+	if s.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(s.PerPage))
+	}
+
+	return []byte(base64.RawURLEncoding.EncodeToString([]byte(v.Encode()))), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (s *PaginationState) UnmarshalText(text []byte) error {
+	data, err := base64.RawURLEncoding.DecodeString(string(text))
+	if err != nil {
+		return ErrInvalidPaginationToken
+	}
+
+	v, err := url.ParseQuery(string(data))
+	if err != nil {
+		return ErrInvalidPaginationToken
+	}
+
+	page, err := strconv.Atoi(v.Get("page"))
+	if err != nil || page < 1 {
+		return ErrInvalidPaginationToken
+	}
+
+	perPage := 0
+	if p := v.Get("per_page"); p != "" {
+		if perPage, err = strconv.Atoi(p); err != nil || perPage < 1 {
+			return ErrInvalidPaginationToken
+		}
+	}
+
+	s.Page = page
+	s.PerPage = perPage
+	return nil
+}
+
+// ListOptions returns the options that request the page s points to.
+func (s PaginationState) ListOptions() *ListOptions {
+	return &ListOptions{Page: s.Page, PerPage: s.PerPage}
+}
+
+// NextPageState returns the position of the page following r, or nil if r is
+// the last page.
+func (r *Response) NextPageState() (*PaginationState, error) {
+	if r.NextPage == "" {
+		return nil, nil
+	}
+
+	u, err := url.Parse(r.NextPage)
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	state := &PaginationState{}
+	if state.Page, err = strconv.Atoi(q.Get("page")); err != nil {
+		return nil, err
+	}
+	if p := q.Get("per_page"); p != "" {
+		if state.PerPage, err = strconv.Atoi(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return state, nil
+}
+
+// listPages calls list with path and then with each following page's path,
+// until the last page, an error, or list reports that it is done. A next link
+// that points back to a page already listed ends the listing rather than
+// requesting it forever. The Response returned is that of the last page
+// requested.
+func listPages(path string, list func(path string) (resp *Response, done bool, err error)) (*Response, error) {
+	seen := map[string]bool{}
+	for {
+		resp, done, err := list(path)
+		if err != nil {
+			return resp, err
+		}
+
+		seen[path] = true
+		if done || resp.NextPage == "" || seen[resp.NextPage] {
+			return resp, nil
+		}
+		path = resp.NextPage
+	}
+}
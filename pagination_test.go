@@ -0,0 +1,57 @@
+package godo
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestPaginationState_marshalling(t *testing.T) {
+	tests := []PaginationState{
+		{Page: 1},
+		{Page: 3, PerPage: 25},
+	}
+
+	for _, state := range tests {
+		text, err := state.MarshalText()
+		if err != nil {
+			t.Fatalf("PaginationState.MarshalText returned error: %v", err)
+		}
+
+		var got PaginationState
+		if err := got.UnmarshalText(text); err != nil {
+			t.Fatalf("PaginationState.UnmarshalText(%q) returned error: %v", text, err)
+		}
+
+		if got != state {
+			t.Errorf("PaginationState round trip = %+v, expected %+v", got, state)
+		}
+	}
+}
+
+func TestPaginationState_UnmarshalText_invalid(t *testing.T) {
+	tests := []string{"", "!!!", "cGFnZT0w", "cGVyX3BhZ2U9Mg"}
+
+	for _, text := range tests {
+		var state PaginationState
+		if err := state.UnmarshalText([]byte(text)); err != ErrInvalidPaginationToken {
+			t.Errorf("PaginationState.UnmarshalText(%q) returned %v, expected %v", text, err, ErrInvalidPaginationToken)
+		}
+	}
+}
+
+func TestResponse_NextPageState(t *testing.T) {
+	r := &Response{
+		Response: &http.Response{},
+		NextPage: "https://api.digitalocean.com/v2/droplets?page=4&per_page=10",
+	}
+
+	state, err := r.NextPageState()
+	if err != nil {
+		t.Fatalf("Response.NextPageState returned error: %v", err)
+	}
+
+	expected := PaginationState{Page: 4, PerPage: 10}
+	if state == nil || *state != expected {
+		t.Errorf("Response.NextPageState = %+v, expected %+v", state, expected)
+	}
+}
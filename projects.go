@@ -0,0 +1,90 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const projectsBasePath = "v2/projects"
+
+// ProjectsService handles communication with the project related methods of
+// the DigitalOcean API.
+type ProjectsService struct {
+	client *Client
+}
+
+// ProjectResource is a resource assigned to a project.
+type ProjectResource struct {
+	// URN of the resource, such as "do:droplet:1234"
+	URN        string     `json:"urn"`
+	AssignedAt *Timestamp `json:"assigned_at,omitempty"`
+	Status     string     `json:"status,omitempty"`
+}
+
+// assignResourcesRequest represents a request to assign resources to a
+// project.
+type assignResourcesRequest struct {
+	Resources []string `json:"resources"`
+}
+
+type projectResourcesRoot struct {
+	Resources []ProjectResource `json:"resources"`
+}
+
+func (r ProjectResource) String() string {
+	return Stringify(r)
+}
+
+// Type returns the type of the resource, such as "droplet" or "domain", taken
+// from its URN. It is empty if the URN is malformed.
+func (r ProjectResource) Type() string {
+	parts := strings.SplitN(r.URN, ":", 3)
+	if len(parts) != 3 || parts[0] != "do" {
+		return ""
+	}
+
+	return parts[1]
+}
+
+// ListResources lists the resources assigned to a project, one page at a
+// time. A nil opt requests the first page.
+func (s *ProjectsService) ListResources(ctx context.Context, projectID string, opt *ListOptions) ([]ProjectResource, *Response, error) {
+	path := fmt.Sprintf("%s/%s/resources", projectsBasePath, projectID)
+	path, err := addOptions(path, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(projectResourcesRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Resources, resp, err
+}
+
+// AssignResources assigns the resources with the given URNs to a project,
+// moving them out of the project they were in.
+func (s *ProjectsService) AssignResources(ctx context.Context, projectID string, urns ...string) ([]ProjectResource, *Response, error) {
+	path := fmt.Sprintf("%s/%s/resources", projectsBasePath, projectID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, &assignResourcesRequest{Resources: urns})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(projectResourcesRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Resources, resp, err
+}
@@ -0,0 +1,105 @@
+package godo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestProjects_ListResources(t *testing.T) {
+	setup()
+	defer teardown()
+
+	projectID := "4e1bfbc3-dc3e-41f2-a18f-1b4d7ba71679"
+	mux.HandleFunc("/v2/projects/"+projectID+"/resources", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"resources":[{"urn":"do:domain:example.com","status":"ok"}]}`)
+			return
+		}
+		w.Header().Set("Link", fmt.Sprintf(`<%s/v2/projects/%s/resources?page=2>; rel="next"`, server.URL, projectID))
+		fmt.Fprint(w, `{"resources":[`+
+			`{"urn":"do:droplet:1","assigned_at":"2018-09-28T19:26:37Z","status":"ok"},`+
+			`{"urn":"do:floatingip:192.0.2.1","status":"ok"}]}`)
+	})
+
+	resources, resp, err := client.Projects.ListResources(ctx, projectID, nil)
+	if err != nil {
+		t.Fatalf("Projects.ListResources returned error: %v", err)
+	}
+
+	expected := []ProjectResource{
+		{URN: "do:droplet:1", AssignedAt: &Timestamp{time.Date(2018, 9, 28, 19, 26, 37, 0, time.UTC)}, Status: "ok"},
+		{URN: "do:floatingip:192.0.2.1", Status: "ok"},
+	}
+	if !reflect.DeepEqual(resources, expected) {
+		t.Errorf("Projects.ListResources returned %+v, expected %+v", resources, expected)
+	}
+	if resp.NextPage == "" {
+		t.Fatal("Projects.ListResources NextPage is empty, expected a second page")
+	}
+
+	resources, resp, err = client.Projects.ListResources(ctx, projectID, &ListOptions{Page: 2})
+	if err != nil {
+		t.Fatalf("Projects.ListResources returned error: %v", err)
+	}
+
+	expected = []ProjectResource{{URN: "do:domain:example.com", Status: "ok"}}
+	if !reflect.DeepEqual(resources, expected) {
+		t.Errorf("Projects.ListResources returned %+v, expected %+v", resources, expected)
+	}
+	if resp.NextPage != "" {
+		t.Errorf("Projects.ListResources NextPage = %v, expected none", resp.NextPage)
+	}
+}
+
+func TestProjectResource_Type(t *testing.T) {
+	tests := map[string]string{
+		"do:droplet:1":            "droplet",
+		"do:floatingip:192.0.2.1": "floatingip",
+		"do:domain:example.com":   "domain",
+		"droplet:1":               "",
+		"":                        "",
+	}
+
+	for urn, expected := range tests {
+		if got := (ProjectResource{URN: urn}).Type(); got != expected {
+			t.Errorf("ProjectResource{URN: %q}.Type() = %q, expected %q", urn, got, expected)
+		}
+	}
+}
+
+func TestProjects_AssignResources(t *testing.T) {
+	setup()
+	defer teardown()
+
+	projectID := "4e1bfbc3-dc3e-41f2-a18f-1b4d7ba71679"
+	mux.HandleFunc("/v2/projects/"+projectID+"/resources", func(w http.ResponseWriter, r *http.Request) {
+		v := new(assignResourcesRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		expected := &assignResourcesRequest{Resources: []string{"do:droplet:1", "do:domain:example.com"}}
+		if !reflect.DeepEqual(v, expected) {
+			t.Errorf("Request body = %+v, expected %+v", v, expected)
+		}
+
+		fmt.Fprint(w, `{"resources":[{"urn":"do:droplet:1","status":"ok"},{"urn":"do:domain:example.com","status":"ok"}]}`)
+	})
+
+	resources, _, err := client.Projects.AssignResources(ctx, projectID, "do:droplet:1", "do:domain:example.com")
+	if err != nil {
+		t.Errorf("Projects.AssignResources returned error: %v", err)
+	}
+
+	expected := []ProjectResource{
+		{URN: "do:droplet:1", Status: "ok"},
+		{URN: "do:domain:example.com", Status: "ok"},
+	}
+	if !reflect.DeepEqual(resources, expected) {
+		t.Errorf("Projects.AssignResources returned %+v, expected %+v", resources, expected)
+	}
+}
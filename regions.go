@@ -1,5 +1,10 @@
 package godo
 
+import (
+	"context"
+	"encoding/json"
+)
+
 // RegionsService handles communication with the region related methods of the
 // DigitalOcean API.
 type RegionsService struct {
@@ -11,7 +16,8 @@ type Region struct {
 	Slug      string   `json:"slug,omitempty"`
 	Name      string   `json:"name,omitempty"`
 	Sizes     []string `json:"sizes,omitempty"`
-	Available bool     `json:"available,omitempty`
+	Available bool     `json:"available,omitempty"`
+	Features  []string `json:"features,omitempty"`
 }
 
 type regionsRoot struct {
@@ -26,11 +32,55 @@ func (r Region) String() string {
 	return Stringify(r)
 }
 
+// UnmarshalJSON decodes a Region, taking its Sizes from the deprecated
+// "sizes_available" field when the response has no "sizes".
+func (r *Region) UnmarshalJSON(data []byte) error {
+	type region Region
+	aux := struct {
+		*region
+		SizesAvailable []string `json:"sizes_available,omitempty"`
+	}{region: (*region)(r)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if len(r.Sizes) == 0 {
+		r.Sizes = aux.SizesAvailable
+	}
+
+	return nil
+}
+
+// HasFeature reports whether the region supports feature, such as
+// "private_networking" or "ipv6".
+func (r Region) HasFeature(feature string) bool {
+	for _, f := range r.Features {
+		if f == feature {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasSize reports whether the size with the given slug is available in the
+// region.
+func (r Region) HasSize(slug string) bool {
+	for _, size := range r.Sizes {
+		if size == slug {
+			return true
+		}
+	}
+
+	return false
+}
+
 // List all regions
-func (s *RegionsService) List() ([]Region, *Response, error) {
+func (s *RegionsService) List(ctx context.Context) ([]Region, *Response, error) {
 	path := "v2/regions"
 
-	req, err := s.client.NewRequest("GET", path, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -43,3 +93,31 @@ func (s *RegionsService) List() ([]Region, *Response, error) {
 
 	return regions.Regions, resp, err
 }
+
+// ListSupporting lists the regions that support all of the given features,
+// such as "ipv6" and "metadata".
+func (s *RegionsService) ListSupporting(ctx context.Context, features ...string) ([]Region, *Response, error) {
+	regions, resp, err := s.List(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var supporting []Region
+	for _, r := range regions {
+		if r.hasFeatures(features) {
+			supporting = append(supporting, r)
+		}
+	}
+
+	return supporting, resp, err
+}
+
+func (r Region) hasFeatures(features []string) bool {
+	for _, f := range features {
+		if !r.HasFeature(f) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -16,7 +16,7 @@ func TestRegions_List(t *testing.T) {
 		fmt.Fprint(w, `{"regions":[{"slug":"1"},{"slug":"2"}]}`)
 	})
 
-	regions, _, err := client.Regions.List()
+	regions, _, err := client.Regions.List(ctx)
 	if err != nil {
 		t.Errorf("Regions.List returned error: %v", err)
 	}
@@ -27,6 +27,78 @@ func TestRegions_List(t *testing.T) {
 	}
 }
 
+func TestRegions_List_sizes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/regions", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"regions":[`+
+			`{"slug":"nyc1","sizes":["512mb","1gb","2gb"]},`+
+			`{"slug":"ams1","sizes_available":["512mb","1gb"]}]}`)
+	})
+
+	regions, _, err := client.Regions.List(ctx)
+	if err != nil {
+		t.Errorf("Regions.List returned error: %v", err)
+	}
+
+	expected := []Region{
+		{Slug: "nyc1", Sizes: []string{"512mb", "1gb", "2gb"}},
+		{Slug: "ams1", Sizes: []string{"512mb", "1gb"}},
+	}
+	if !reflect.DeepEqual(regions, expected) {
+		t.Errorf("Regions.List returned %+v, expected %+v", regions, expected)
+	}
+}
+
+func TestRegions_ListSupporting(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/regions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"regions":[`+
+			`{"slug":"nyc1","features":["ipv6","metadata"]},`+
+			`{"slug":"ams1","features":["ipv6"]},`+
+			`{"slug":"sfo1","features":["backups","metadata","ipv6"]},`+
+			`{"slug":"sgp1"}]}`)
+	})
+
+	regions, _, err := client.Regions.ListSupporting(ctx, "ipv6", "metadata")
+	if err != nil {
+		t.Errorf("Regions.ListSupporting returned error: %v", err)
+	}
+
+	expected := []Region{
+		{Slug: "nyc1", Features: []string{"ipv6", "metadata"}},
+		{Slug: "sfo1", Features: []string{"backups", "metadata", "ipv6"}},
+	}
+	if !reflect.DeepEqual(regions, expected) {
+		t.Errorf("Regions.ListSupporting returned %+v, expected %+v", regions, expected)
+	}
+}
+
+func TestRegion_HasFeature(t *testing.T) {
+	region := Region{Slug: "nyc1", Features: []string{"backups", "ipv6"}}
+
+	if !region.HasFeature("ipv6") {
+		t.Error("Region.HasFeature(\"ipv6\") = false, expected true")
+	}
+	if region.HasFeature("private_networking") {
+		t.Error("Region.HasFeature(\"private_networking\") = true, expected false")
+	}
+}
+
+func TestRegion_HasSize(t *testing.T) {
+	region := Region{Slug: "nyc1", Sizes: []string{"512mb", "1gb", "2gb"}}
+
+	for slug, expected := range map[string]bool{"512mb": true, "2gb": true, "4gb": false, "": false} {
+		if got := region.HasSize(slug); got != expected {
+			t.Errorf("Region.HasSize(%q) = %v, expected %v", slug, got, expected)
+		}
+	}
+}
+
 func TestRegion_String(t *testing.T) {
 	region := &Region{
 		Slug:      "region",
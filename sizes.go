@@ -1,5 +1,7 @@
 package godo
 
+import "context"
+
 // SizesService handles communication with the size related methods of the
 // DigitalOcean API.
 type SizesService struct {
@@ -12,9 +14,11 @@ type Size struct {
 	Memory       int      `json:"memory,omitempty"`
 	Vcpus        int      `json:"vcpus,omitempty"`
 	Disk         int      `json:"disk,omitempty"`
+	Transfer     float64  `json:"transfer,omitempty"`
 	PriceMonthly float64  `json:"price_monthly,omitempty"`
 	PriceHourly  float64  `json:"price_hourly,omitempty"`
 	Regions      []string `json:"regions,omitempty"`
+	Available    bool     `json:"available,omitempty"`
 }
 
 func (s Size) String() string {
@@ -22,14 +26,14 @@ func (s Size) String() string {
 }
 
 type sizesRoot struct {
-	Sizes []Size
+	Sizes []Size `json:"sizes"`
 }
 
-// List all images
-func (s *SizesService) List() ([]Size, *Response, error) {
+// List all sizes
+func (s *SizesService) List(ctx context.Context) ([]Size, *Response, error) {
 	path := "v2/sizes"
 
-	req, err := s.client.NewRequest("GET", path, nil)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
 	if err != nil {
 		return nil, nil, err
 	}
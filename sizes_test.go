@@ -16,7 +16,7 @@ func TestSizes_List(t *testing.T) {
 		fmt.Fprint(w, `{"sizes":[{"slug":"1"},{"slug":"2"}]}`)
 	})
 
-	sizes, _, err := client.Sizes.List()
+	sizes, _, err := client.Sizes.List(ctx)
 	if err != nil {
 		t.Errorf("Sizes.List returned error: %v", err)
 	}
@@ -27,6 +27,52 @@ func TestSizes_List(t *testing.T) {
 	}
 }
 
+func TestSizes_List_payload(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/sizes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"sizes": [
+				{
+					"slug": "512mb",
+					"memory": 512,
+					"vcpus": 1,
+					"disk": 20,
+					"transfer": 1.0,
+					"price_monthly": 5.0,
+					"price_hourly": 0.00744,
+					"regions": ["nyc1", "sfo1"],
+					"available": true
+				}
+			]
+		}`)
+	})
+
+	sizes, _, err := client.Sizes.List(ctx)
+	if err != nil {
+		t.Errorf("Sizes.List returned error: %v", err)
+	}
+
+	expected := []Size{
+		{
+			Slug:         "512mb",
+			Memory:       512,
+			Vcpus:        1,
+			Disk:         20,
+			Transfer:     1.0,
+			PriceMonthly: 5.0,
+			PriceHourly:  0.00744,
+			Regions:      []string{"nyc1", "sfo1"},
+			Available:    true,
+		},
+	}
+	if !reflect.DeepEqual(sizes, expected) {
+		t.Errorf("Sizes.List returned %+v, expected %+v", sizes, expected)
+	}
+}
+
 func TestSize_String(t *testing.T) {
 	size := &Size{
 		Slug:         "slize",
@@ -39,7 +85,7 @@ func TestSize_String(t *testing.T) {
 	}
 
 	stringified := size.String()
-	expected := `godo.Size{Slug:"slize", Memory:123, Vcpus:456, Disk:789, PriceMonthly:123, PriceHourly:456, Regions:["1" "2"]}`
+	expected := `godo.Size{Slug:"slize", Memory:123, Vcpus:456, Disk:789, Transfer:0, PriceMonthly:123, PriceHourly:456, Regions:["1" "2"], Available:false}`
 	if expected != stringified {
 		t.Errorf("Size.String returned %+v, expected %+v", stringified, expected)
 	}
@@ -0,0 +1,117 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+)
+
+const storageVolumesBasePath = "v2/volumes"
+
+// StorageService handles communication with the block storage related
+// methods of the DigitalOcean API.
+type StorageService struct {
+	client *Client
+}
+
+// Volume represents a DigitalOcean block storage volume
+type Volume struct {
+	ID            string  `json:"id,omitempty"`
+	Region        *Region `json:"region,omitempty"`
+	Name          string  `json:"name,omitempty"`
+	SizeGigaBytes int64   `json:"size_gigabytes,omitempty"`
+	Description   string  `json:"description,omitempty"`
+}
+
+func (v Volume) String() string {
+	return Stringify(v)
+}
+
+// VolumeCreateRequest represents a request to create a block storage volume.
+type VolumeCreateRequest struct {
+	Name          string `json:"name"`
+	SizeGigaBytes int64  `json:"size_gigabytes"`
+	Region        string `json:"region"`
+	Description   string `json:"description,omitempty"`
+}
+
+func (v VolumeCreateRequest) String() string {
+	return Stringify(v)
+}
+
+type volumeRoot struct {
+	Volume Volume `json:"volume"`
+}
+
+type volumesRoot struct {
+	Volumes []Volume `json:"volumes"`
+}
+
+// ListVolumes lists block storage volumes, one page at a time. A nil opt
+// requests the first page.
+func (s *StorageService) ListVolumes(ctx context.Context, opt *ListOptions) ([]Volume, *Response, error) {
+	path, err := addOptions(storageVolumesBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(volumesRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Volumes, resp, err
+}
+
+// GetVolume gets a block storage volume by id
+func (s *StorageService) GetVolume(ctx context.Context, id string) (*Volume, *Response, error) {
+	path := fmt.Sprintf("%s/%s", storageVolumesBasePath, id)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(volumeRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &root.Volume, resp, err
+}
+
+// CreateVolume creates a block storage volume using a VolumeCreateRequest
+func (s *StorageService) CreateVolume(ctx context.Context, createRequest *VolumeCreateRequest) (*Volume, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", storageVolumesBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(volumeRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &root.Volume, resp, err
+}
+
+// DeleteVolume deletes a block storage volume by id
+func (s *StorageService) DeleteVolume(ctx context.Context, id string) (*Response, error) {
+	path := fmt.Sprintf("%s/%s", storageVolumesBasePath, id)
+
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+
+	return resp, err
+}
@@ -0,0 +1,71 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+)
+
+// StorageActionsService handles communication with the block storage volume
+// action related methods of the DigitalOcean API.
+type StorageActionsService struct {
+	client *Client
+}
+
+// Attach a volume to a droplet. region is the slug of the region the volume
+// and droplet are in; it may be empty.
+func (s *StorageActionsService) Attach(ctx context.Context, volumeID string, dropletID int, region string) (*Action, *Response, error) {
+	request := &ActionRequest{
+		Type:   "attach",
+		Params: volumeActionParams(dropletID, region),
+	}
+	return s.doAction(ctx, volumeID, request)
+}
+
+// DetachByDropletID detaches a volume from a droplet. region is the slug of
+// the region the volume and droplet are in; it may be empty.
+func (s *StorageActionsService) DetachByDropletID(ctx context.Context, volumeID string, dropletID int, region string) (*Action, *Response, error) {
+	request := &ActionRequest{
+		Type:   "detach",
+		Params: volumeActionParams(dropletID, region),
+	}
+	return s.doAction(ctx, volumeID, request)
+}
+
+// volumeActionParams returns the params of an action that attaches a volume
+// to or detaches it from a droplet.
+func volumeActionParams(dropletID int, region string) map[string]interface{} {
+	params := map[string]interface{}{"droplet_id": dropletID}
+	if region != "" {
+		params["region"] = region
+	}
+	return params
+}
+
+// Resize a volume in region to sizeGigaBytes
+func (s *StorageActionsService) Resize(ctx context.Context, volumeID string, sizeGigaBytes int, region string) (*Action, *Response, error) {
+	request := &ActionRequest{
+		Type: "resize",
+		Params: map[string]interface{}{
+			"size_gigabytes": sizeGigaBytes,
+			"region":         region,
+		},
+	}
+	return s.doAction(ctx, volumeID, request)
+}
+
+func (s *StorageActionsService) doAction(ctx context.Context, volumeID string, request *ActionRequest) (*Action, *Response, error) {
+	path := fmt.Sprintf("%s/%s/actions", storageVolumesBasePath, volumeID)
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(actionRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &root.Event, resp, err
+}
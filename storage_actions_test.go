@@ -0,0 +1,186 @@
+package godo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testVolumeID = "80d414c6-295e-4e3a-ac58-eb9456c1e1d1"
+
+func TestStorageActions_Attach(t *testing.T) {
+	setup()
+	defer teardown()
+
+	attachRequest := &ActionRequest{
+		Type:   "attach",
+		Params: map[string]interface{}{"droplet_id": float64(12345), "region": "nyc1"},
+	}
+
+	mux.HandleFunc("/v2/volumes/"+testVolumeID+"/actions", func(w http.ResponseWriter, r *http.Request) {
+		v := new(ActionRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, attachRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, attachRequest)
+		}
+
+		fmt.Fprint(w, `{"action":{"id":1,"status":"in-progress","type":"attach_volume",`+
+			`"resource_type":"volume","region":{"slug":"nyc1"}}}`)
+	})
+
+	action, _, err := client.StorageActions.Attach(ctx, testVolumeID, 12345, "nyc1")
+	if err != nil {
+		t.Errorf("StorageActions.Attach returned error: %v", err)
+	}
+
+	expected := &Action{ID: 1, Status: "in-progress", Type: "attach_volume", ResourceType: "volume"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("StorageActions.Attach returned %+v, expected %+v", action, expected)
+	}
+}
+
+func TestStorageActions_Attach_body(t *testing.T) {
+	tests := []struct {
+		region   string
+		expected string
+	}{
+		{"nyc1", `{"type":"attach","droplet_id":12345,"region":"nyc1"}`},
+		{"", `{"type":"attach","droplet_id":12345}`},
+	}
+
+	for _, tt := range tests {
+		setup()
+
+		mux.HandleFunc("/v2/volumes/"+testVolumeID+"/actions", func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+
+			if got := strings.TrimSpace(string(body)); got != tt.expected {
+				t.Errorf("Request body = %v, expected %v", got, tt.expected)
+			}
+
+			fmt.Fprint(w, `{"action":{"id":1}}`)
+		})
+
+		_, _, err := client.StorageActions.Attach(ctx, testVolumeID, 12345, tt.region)
+		if err != nil {
+			t.Errorf("StorageActions.Attach returned error: %v", err)
+		}
+
+		teardown()
+	}
+}
+
+func TestStorageActions_Resize_body(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes/"+testVolumeID+"/actions", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		expected := `{"type":"resize","region":"nyc1","size_gigabytes":100}`
+		if got := strings.TrimSpace(string(body)); got != expected {
+			t.Errorf("Request body = %v, expected %v", got, expected)
+		}
+
+		fmt.Fprint(w, `{"action":{"id":1}}`)
+	})
+
+	_, _, err := client.StorageActions.Resize(ctx, testVolumeID, 100, "nyc1")
+	if err != nil {
+		t.Errorf("StorageActions.Resize returned error: %v", err)
+	}
+}
+
+func TestStorageActions_Attach_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes/"+testVolumeID+"/actions", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"id":"unprocessable_entity","message":"droplet is not in the volume's region"}`)
+	})
+
+	action, _, err := client.StorageActions.Attach(ctx, testVolumeID, 12345, "nyc1")
+	if err == nil {
+		t.Fatal("Expected error to be returned.")
+	}
+	if action != nil {
+		t.Errorf("StorageActions.Attach returned %+v, expected nil", action)
+	}
+	if errResp, ok := err.(*ErrorResponse); !ok || errResp.Message != "droplet is not in the volume's region" {
+		t.Errorf("Expected the API's error message; got %#v", err)
+	}
+}
+
+func TestStorageActions_DetachByDropletID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	detachRequest := &ActionRequest{
+		Type:   "detach",
+		Params: map[string]interface{}{"droplet_id": float64(12345), "region": "nyc1"},
+	}
+
+	mux.HandleFunc("/v2/volumes/"+testVolumeID+"/actions", func(w http.ResponseWriter, r *http.Request) {
+		v := new(ActionRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, detachRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, detachRequest)
+		}
+
+		fmt.Fprint(w, `{"action":{"id":2,"status":"in-progress","type":"detach_volume"}}`)
+	})
+
+	action, _, err := client.StorageActions.DetachByDropletID(ctx, testVolumeID, 12345, "nyc1")
+	if err != nil {
+		t.Errorf("StorageActions.DetachByDropletID returned error: %v", err)
+	}
+
+	expected := &Action{ID: 2, Status: "in-progress", Type: "detach_volume"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("StorageActions.DetachByDropletID returned %+v, expected %+v", action, expected)
+	}
+}
+
+func TestStorageActions_Resize(t *testing.T) {
+	setup()
+	defer teardown()
+
+	resizeRequest := &ActionRequest{
+		Type: "resize",
+		Params: map[string]interface{}{
+			"size_gigabytes": float64(100),
+			"region":         "nyc1",
+		},
+	}
+
+	mux.HandleFunc("/v2/volumes/"+testVolumeID+"/actions", func(w http.ResponseWriter, r *http.Request) {
+		v := new(ActionRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, resizeRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, resizeRequest)
+		}
+
+		fmt.Fprint(w, `{"action":{"id":3,"status":"in-progress","type":"resize_volume"}}`)
+	})
+
+	action, _, err := client.StorageActions.Resize(ctx, testVolumeID, 100, "nyc1")
+	if err != nil {
+		t.Errorf("StorageActions.Resize returned error: %v", err)
+	}
+
+	expected := &Action{ID: 3, Status: "in-progress", Type: "resize_volume"}
+	if !reflect.DeepEqual(action, expected) {
+		t.Errorf("StorageActions.Resize returned %+v, expected %+v", action, expected)
+	}
+}
@@ -0,0 +1,110 @@
+package godo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestStorage_ListVolumes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"volumes":[`+
+			`{"id":"506f78a4-e098-11e5-ad9f-000f53306ae1","region":{"slug":"nyc1"},"name":"my-volume","size_gigabytes":10},`+
+			`{"id":"2d2967ff-491d-11e6-860c-000f53315870","region":{"slug":"nyc1"},"name":"db","size_gigabytes":100,"description":"database"}]}`)
+	})
+
+	volumes, _, err := client.Storage.ListVolumes(ctx, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Storage.ListVolumes returned error: %v", err)
+	}
+
+	expected := []Volume{
+		{ID: "506f78a4-e098-11e5-ad9f-000f53306ae1", Region: &Region{Slug: "nyc1"}, Name: "my-volume", SizeGigaBytes: 10},
+		{ID: "2d2967ff-491d-11e6-860c-000f53315870", Region: &Region{Slug: "nyc1"}, Name: "db", SizeGigaBytes: 100, Description: "database"},
+	}
+	if !reflect.DeepEqual(volumes, expected) {
+		t.Errorf("Storage.ListVolumes returned %+v, expected %+v", volumes, expected)
+	}
+}
+
+func TestStorage_GetVolume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes/80d414c6-295e-4e3a-ac58-eb9456c1e1d1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"volume":{"id":"80d414c6-295e-4e3a-ac58-eb9456c1e1d1","name":"my-volume"}}`)
+	})
+
+	volume, _, err := client.Storage.GetVolume(ctx, "80d414c6-295e-4e3a-ac58-eb9456c1e1d1")
+	if err != nil {
+		t.Errorf("Storage.GetVolume returned error: %v", err)
+	}
+
+	expected := &Volume{ID: "80d414c6-295e-4e3a-ac58-eb9456c1e1d1", Name: "my-volume"}
+	if !reflect.DeepEqual(volume, expected) {
+		t.Errorf("Storage.GetVolume returned %+v, expected %+v", volume, expected)
+	}
+}
+
+func TestStorage_CreateVolume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &VolumeCreateRequest{
+		Name:          "my-volume",
+		SizeGigaBytes: 10,
+		Region:        "nyc1",
+		Description:   "my description",
+	}
+
+	mux.HandleFunc("/v2/volumes", func(w http.ResponseWriter, r *http.Request) {
+		v := new(VolumeCreateRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, createRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, createRequest)
+		}
+
+		fmt.Fprint(w, `{"volume":{"id":"80d414c6-295e-4e3a-ac58-eb9456c1e1d1","region":{"slug":"nyc1"},`+
+			`"name":"my-volume","size_gigabytes":10,"description":"my description"}}`)
+	})
+
+	volume, _, err := client.Storage.CreateVolume(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Storage.CreateVolume returned error: %v", err)
+	}
+
+	expected := &Volume{
+		ID:            "80d414c6-295e-4e3a-ac58-eb9456c1e1d1",
+		Region:        &Region{Slug: "nyc1"},
+		Name:          "my-volume",
+		SizeGigaBytes: 10,
+		Description:   "my description",
+	}
+	if !reflect.DeepEqual(volume, expected) {
+		t.Errorf("Storage.CreateVolume returned %+v, expected %+v", volume, expected)
+	}
+}
+
+func TestStorage_DeleteVolume(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/volumes/80d414c6-295e-4e3a-ac58-eb9456c1e1d1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Storage.DeleteVolume(ctx, "80d414c6-295e-4e3a-ac58-eb9456c1e1d1")
+	if err != nil {
+		t.Errorf("Storage.DeleteVolume returned error: %v", err)
+	}
+}
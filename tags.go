@@ -0,0 +1,161 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const tagsBasePath = "v2/tags"
+
+// TagsService handles communication with the tag related methods of the
+// DigitalOcean API.
+type TagsService struct {
+	client *Client
+}
+
+// Tag represents a DigitalOcean Tag
+type Tag struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (t Tag) String() string {
+	return Stringify(t)
+}
+
+// TagCreateRequest represents a request to create a tag.
+type TagCreateRequest struct {
+	Name string `json:"name"`
+}
+
+// Resource identifies a resource to tag or untag, such as a droplet.
+type Resource struct {
+	ID   string `json:"resource_id"`
+	Type string `json:"resource_type"`
+}
+
+// TagResourcesRequest represents a request to tag resources.
+type TagResourcesRequest struct {
+	Resources []Resource `json:"resources"`
+}
+
+// UntagResourcesRequest represents a request to untag resources.
+type UntagResourcesRequest struct {
+	Resources []Resource `json:"resources"`
+}
+
+type tagRoot struct {
+	Tag Tag `json:"tag"`
+}
+
+type tagsRoot struct {
+	Tags []Tag `json:"tags"`
+}
+
+// List tags, one page at a time. A nil opt requests the first page.
+func (s *TagsService) List(ctx context.Context, opt *ListOptions) ([]Tag, *Response, error) {
+	path, err := addOptions(tagsBasePath, opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(tagsRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Tags, resp, err
+}
+
+// Get a tag by name
+func (s *TagsService) Get(ctx context.Context, name string) (*Tag, *Response, error) {
+	path := fmt.Sprintf("%s/%s", tagsBasePath, name)
+
+	req, err := s.client.NewRequestWithContext(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(tagRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &root.Tag, resp, err
+}
+
+// Create a tag using a TagCreateRequest
+func (s *TagsService) Create(ctx context.Context, createRequest *TagCreateRequest) (*Tag, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "POST", tagsBasePath, createRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(tagRoot)
+	resp, err := s.client.Do(req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &root.Tag, resp, err
+}
+
+// EnsureExists creates the tag with the given name, treating a tag that
+// already exists as success. The tag is returned in either case.
+func (s *TagsService) EnsureExists(ctx context.Context, name string) (*Tag, *Response, error) {
+	tag, resp, err := s.Create(ctx, &TagCreateRequest{Name: name})
+	if errResp, ok := err.(*ErrorResponse); ok && errResp.Response.StatusCode == http.StatusConflict {
+		return s.Get(ctx, name)
+	}
+
+	return tag, resp, err
+}
+
+// Delete a tag by name. Resources with the tag are untagged.
+func (s *TagsService) Delete(ctx context.Context, name string) (*Response, error) {
+	path := fmt.Sprintf("%s/%s", tagsBasePath, name)
+
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+
+	return resp, err
+}
+
+// TagResources tags the resources in tagRequest with the named tag
+func (s *TagsService) TagResources(ctx context.Context, name string, tagRequest *TagResourcesRequest) (*Response, error) {
+	path := fmt.Sprintf("%s/%s/resources", tagsBasePath, name)
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", path, tagRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+
+	return resp, err
+}
+
+// UntagResources removes the named tag from the resources in untagRequest
+func (s *TagsService) UntagResources(ctx context.Context, name string, untagRequest *UntagResourcesRequest) (*Response, error) {
+	path := fmt.Sprintf("%s/%s/resources", tagsBasePath, name)
+
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", path, untagRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+
+	return resp, err
+}
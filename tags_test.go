@@ -0,0 +1,191 @@
+package godo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestTags_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testFormValues(t, r, values{"page": "2"})
+		fmt.Fprint(w, `{"tags":[{"name":"web"},{"name":"db"}]}`)
+	})
+
+	tags, _, err := client.Tags.List(ctx, &ListOptions{Page: 2})
+	if err != nil {
+		t.Errorf("Tags.List returned error: %v", err)
+	}
+
+	expected := []Tag{{Name: "web"}, {Name: "db"}}
+	if !reflect.DeepEqual(tags, expected) {
+		t.Errorf("Tags.List returned %+v, expected %+v", tags, expected)
+	}
+}
+
+func TestTags_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags/web", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"tag":{"name":"web"}}`)
+	})
+
+	tag, _, err := client.Tags.Get(ctx, "web")
+	if err != nil {
+		t.Errorf("Tags.Get returned error: %v", err)
+	}
+
+	expected := &Tag{Name: "web"}
+	if !reflect.DeepEqual(tag, expected) {
+		t.Errorf("Tags.Get returned %+v, expected %+v", tag, expected)
+	}
+}
+
+func TestTags_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &TagCreateRequest{Name: "web"}
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		v := new(TagCreateRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "POST")
+		if !reflect.DeepEqual(v, createRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, createRequest)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"tag":{"name":"web"}}`)
+	})
+
+	tag, _, err := client.Tags.Create(ctx, createRequest)
+	if err != nil {
+		t.Errorf("Tags.Create returned error: %v", err)
+	}
+
+	expected := &Tag{Name: "web"}
+	if !reflect.DeepEqual(tag, expected) {
+		t.Errorf("Tags.Create returned %+v, expected %+v", tag, expected)
+	}
+}
+
+func TestTags_EnsureExists_alreadyExists(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"tag already exists"}`)
+	})
+
+	mux.HandleFunc("/v2/tags/web", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"tag":{"name":"web"}}`)
+	})
+
+	tag, _, err := client.Tags.EnsureExists(ctx, "web")
+	if err != nil {
+		t.Errorf("Tags.EnsureExists returned error: %v", err)
+	}
+
+	expected := &Tag{Name: "web"}
+	if !reflect.DeepEqual(tag, expected) {
+		t.Errorf("Tags.EnsureExists returned %+v, expected %+v", tag, expected)
+	}
+}
+
+func TestTags_EnsureExists_error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"invalid name"}`)
+	})
+
+	_, _, err := client.Tags.EnsureExists(ctx, "not valid")
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Tags.EnsureExists returned error %#v, expected an *ErrorResponse", err)
+	}
+}
+
+func TestTags_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags/web", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Tags.Delete(ctx, "web")
+	if err != nil {
+		t.Errorf("Tags.Delete returned error: %v", err)
+	}
+}
+
+func TestTags_TagResources(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v2/tags/web/resources", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+
+		var v map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&v)
+		expected := map[string]interface{}{
+			"resources": []interface{}{
+				map[string]interface{}{"resource_id": "12345", "resource_type": "droplet"},
+			},
+		}
+		if !reflect.DeepEqual(v, expected) {
+			t.Errorf("Request body = %+v, expected %+v", v, expected)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tagRequest := &TagResourcesRequest{
+		Resources: []Resource{{ID: "12345", Type: "droplet"}},
+	}
+	_, err := client.Tags.TagResources(ctx, "web", tagRequest)
+	if err != nil {
+		t.Errorf("Tags.TagResources returned error: %v", err)
+	}
+}
+
+func TestTags_UntagResources(t *testing.T) {
+	setup()
+	defer teardown()
+
+	untagRequest := &UntagResourcesRequest{
+		Resources: []Resource{{ID: "12345", Type: "droplet"}},
+	}
+
+	mux.HandleFunc("/v2/tags/web/resources", func(w http.ResponseWriter, r *http.Request) {
+		v := new(UntagResourcesRequest)
+		json.NewDecoder(r.Body).Decode(v)
+
+		testMethod(t, r, "DELETE")
+		if !reflect.DeepEqual(v, untagRequest) {
+			t.Errorf("Request body = %+v, expected %+v", v, untagRequest)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Tags.UntagResources(ctx, "web", untagRequest)
+	if err != nil {
+		t.Errorf("Tags.UntagResources returned error: %v", err)
+	}
+}
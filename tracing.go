@@ -0,0 +1,30 @@
+package godo
+
+import "context"
+
+// Tracer starts the spans a Client records for the requests it sends. It is a
+// minimal interface so that tracing libraries such as OpenTelemetry can be
+// plugged in with a small adapter, without godo depending on them.
+type Tracer interface {
+	// StartSpan starts a span named name as a child of any span in ctx, and
+	// returns a context carrying the new span.
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// SetAttribute tags the span with a key and value, such as the HTTP
+	// method of a request.
+	SetAttribute(key string, value interface{})
+
+	// End completes the span.
+	End()
+}
+
+// Attribute keys of the spans started for requests, following the
+// OpenTelemetry semantic conventions for HTTP clients.
+const (
+	spanAttributeMethod     = "http.method"
+	spanAttributePath       = "http.target"
+	spanAttributeStatusCode = "http.status_code"
+)
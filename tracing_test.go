@@ -0,0 +1,105 @@
+package godo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type fakeSpan struct {
+	name       string
+	attributes map[string]interface{}
+	ended      bool
+}
+
+func (s *fakeSpan) SetAttribute(key string, value interface{}) {
+	s.attributes[key] = value
+}
+
+func (s *fakeSpan) End() {
+	s.ended = true
+}
+
+type spanKey struct{}
+
+type fakeTracer struct {
+	mu    sync.Mutex
+	spans []*fakeSpan
+}
+
+func (t *fakeTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	span := &fakeSpan{name: name, attributes: map[string]interface{}{}}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func TestDo_tracer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tracer := new(fakeTracer)
+	client.Tracer = tracer
+
+	mux.HandleFunc("/v2/droplets/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"droplet":{"id":1}}`)
+	})
+	mux.HandleFunc("/v2/droplets/2", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+	})
+
+	client.Droplet.Get(ctx, 1)
+	client.Droplet.Get(ctx, 2)
+
+	expected := []*fakeSpan{
+		{
+			name: "HTTP GET",
+			attributes: map[string]interface{}{
+				"http.method":      "GET",
+				"http.target":      "/v2/droplets/1",
+				"http.status_code": 200,
+			},
+			ended: true,
+		},
+		{
+			name: "HTTP GET",
+			attributes: map[string]interface{}{
+				"http.method":      "GET",
+				"http.target":      "/v2/droplets/2",
+				"http.status_code": 404,
+			},
+			ended: true,
+		},
+	}
+	if !reflect.DeepEqual(tracer.spans, expected) {
+		t.Errorf("Spans = %+v, expected %+v", tracer.spans, expected)
+	}
+}
+
+func TestDo_tracerContext(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var spanned bool
+	client = NewTestClient(func(r *http.Request) (*http.Response, error) {
+		spanned = r.Context().Value(spanKey{}) != nil
+		return http.DefaultTransport.RoundTrip(r)
+	})
+	client.BaseURL, _ = url.Parse(server.URL)
+	client.Tracer = new(fakeTracer)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {})
+
+	req, _ := client.NewRequest("GET", "/", nil)
+	client.Do(req, nil)
+
+	if !spanned {
+		t.Error("Request sent without the span's context")
+	}
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -14,8 +15,9 @@ const (
 	activeFailure = 3
 )
 
-// WaitForActive waits for a droplet to become active
-func WaitForActive(client *godo.Client, monitorURI string) error {
+// WaitForActive waits for a droplet to become active, or returns the context's
+// error if ctx is done first.
+func WaitForActive(ctx context.Context, client *godo.Client, monitorURI string) error {
 	if len(monitorURI) == 0 {
 		return fmt.Errorf("create had no monitor uri")
 	}
@@ -23,9 +25,12 @@ func WaitForActive(client *godo.Client, monitorURI string) error {
 	completed := false
 	failCount := 0
 	for !completed {
-		action, _, err := client.DropletActions.GetByURI(monitorURI)
+		action, _, err := client.DropletActions.GetByURI(ctx, monitorURI)
 
 		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
 			if failCount <= activeFailure {
 				failCount++
 				continue
@@ -35,7 +40,13 @@ func WaitForActive(client *godo.Client, monitorURI string) error {
 
 		switch action.Status {
 		case godo.ActionInProgress:
-			time.Sleep(5 * time.Second)
+			timer := time.NewTimer(client.ActionPollInterval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
 		case godo.ActionCompleted:
 			completed = true
 		default:
@@ -0,0 +1,42 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/digitaloceancloud/godo"
+)
+
+func TestWaitForActive_canceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"action":{"id":1,"status":"in-progress"}}`)
+	}))
+	defer server.Close()
+
+	client := godo.NewClient(nil)
+	client.BaseURL, _ = url.Parse(server.URL)
+	client.ActionPollInterval = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- WaitForActive(ctx, client, server.URL+"/v2/actions/1")
+	}()
+
+	select {
+	case err := <-errc:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("WaitForActive returned %v, expected %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("WaitForActive did not return after the context was canceled")
+	}
+}